@@ -0,0 +1,307 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Annotation marks a stretch of a video, such as the part where a
+// particular person speaks.  Times are in seconds from the start.
+type Annotation struct {
+	ID    int
+	Start float64
+	End   float64
+	Label string
+	Tags  []string `json:",omitempty"`
+	Note  string   `json:",omitempty"`
+}
+
+// allTags returns the tags of the entry along with the tags of its
+// annotations.
+func (e *LibraryEntry) allTags() []string {
+	if len(e.Annotations) == 0 {
+		return e.Tags
+	}
+	tags := append([]string{}, e.Tags...)
+	for _, a := range e.Annotations {
+		tags = append(tags, a.Tags...)
+	}
+	return tags
+}
+
+// formatTimecode writes seconds as mm:ss, or h:mm:ss for times of an
+// hour or more, the way clip titles and chapter lists show times.
+func formatTimecode(sec float64) string {
+	s := int(sec)
+	if s >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+	}
+	return fmt.Sprintf("%02d:%02d", s/60, s%60)
+}
+
+// parseTimecode reads a time written as seconds, m:ss or h:mm:ss,
+// where the seconds may have a fraction.
+func parseTimecode(s string) (float64, error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("bad time %q", s)
+	}
+	var sec float64
+	for i, p := range parts {
+		n, err := strconv.ParseFloat(p, 64)
+		if err != nil || n < 0 || (i > 0 && n >= 60) {
+			return 0, fmt.Errorf("bad time %q", s)
+		}
+		sec = sec*60 + n
+	}
+	return sec, nil
+}
+
+// checkAnnotation validates an annotation about to be added and
+// applies the tag rules and namespaces to its tags.  Annotation tags
+// can't be held for approval, so with a vocabulary in force unknown
+// tags are rejected.  The caller must hold libraryLock.
+func checkAnnotation(a *Annotation) error {
+	a.Label = strings.TrimSpace(a.Label)
+	a.Note = strings.TrimSpace(a.Note)
+	switch {
+	case a.Start < 0 || a.End < 0:
+		return fmt.Errorf("annotation times can't be negative")
+	case a.End < a.Start:
+		return fmt.Errorf("annotation ends before it starts")
+	case a.Label == "" && len(cleanTags(a.Tags)) == 0:
+		return fmt.Errorf("annotation needs a label or tags")
+	}
+
+	tags, err := namespaces.check(tagRules.apply(a.Tags))
+	if err != nil {
+		return err
+	}
+	if _, unknown := vocab.check(tags); len(unknown) > 0 {
+		return vocabTagsError(unknown)
+	}
+	a.Tags = tags
+	return nil
+}
+
+// addAnnotation adds an annotation to an entry and returns the
+// annotations of the entry.
+func addAnnotation(file string, a Annotation) ([]Annotation, error) {
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
+
+	old := library[file]
+	if old == nil {
+		return nil, fmt.Errorf("no such file %q", file)
+	}
+	if err := checkAnnotation(&a); err != nil {
+		return nil, err
+	}
+
+	e := *old
+	e.Annotations = append([]Annotation{}, old.Annotations...)
+	a.ID = 1
+	for _, o := range e.Annotations {
+		if o.ID >= a.ID {
+			a.ID = o.ID + 1
+		}
+	}
+	e.Annotations = append(e.Annotations, a)
+	sort.SliceStable(e.Annotations, func(i, j int) bool { return e.Annotations[i].Start < e.Annotations[j].Start })
+	saveAnnotations(&e)
+	log.Printf("Added annotation %d to %s at %s", a.ID, file, formatTimecode(a.Start))
+	return e.Annotations, nil
+}
+
+// addAnnotations adds several annotations to an entry in one update
+// and returns the annotations of the entry.  Annotations covering the
+// same range with the same label as one the entry already has are
+// skipped, so adding the same list twice changes nothing.
+func addAnnotations(file string, list []Annotation) ([]Annotation, error) {
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
+
+	old := library[file]
+	if old == nil {
+		return nil, fmt.Errorf("no such file %q", file)
+	}
+	type span struct {
+		start, end float64
+		label      string
+	}
+	seen := make(map[span]bool, len(old.Annotations))
+	id := 0
+	for _, o := range old.Annotations {
+		seen[span{o.Start, o.End, o.Label}] = true
+		if o.ID > id {
+			id = o.ID
+		}
+	}
+
+	e := *old
+	e.Annotations = append([]Annotation{}, old.Annotations...)
+	added := 0
+	for _, a := range list {
+		if err := checkAnnotation(&a); err != nil {
+			return nil, err
+		}
+		k := span{a.Start, a.End, a.Label}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		id++
+		a.ID = id
+		e.Annotations = append(e.Annotations, a)
+		added++
+	}
+	if added == 0 {
+		return old.Annotations, nil
+	}
+	sort.SliceStable(e.Annotations, func(i, j int) bool { return e.Annotations[i].Start < e.Annotations[j].Start })
+	saveAnnotations(&e)
+	log.Printf("Added %d annotations to %s", added, file)
+	return e.Annotations, nil
+}
+
+// deleteAnnotation removes an annotation from an entry and returns
+// the remaining annotations.
+func deleteAnnotation(file string, id int) ([]Annotation, error) {
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
+
+	old := library[file]
+	if old == nil {
+		return nil, fmt.Errorf("no such file %q", file)
+	}
+	e := *old
+	e.Annotations = nil
+	for _, a := range old.Annotations {
+		if a.ID != id {
+			e.Annotations = append(e.Annotations, a)
+		}
+	}
+	if len(e.Annotations) == len(old.Annotations) {
+		return nil, fmt.Errorf("no annotation %d on %s", id, file)
+	}
+	saveAnnotations(&e)
+	log.Printf("Deleted annotation %d from %s", id, file)
+	return e.Annotations, nil
+}
+
+// saveAnnotations stores an entry whose annotations changed.  The
+// caller must hold libraryLock for writing.
+func saveAnnotations(e *LibraryEntry) {
+	library[e.Filename] = e
+	index.update(e)
+	dbDirty = true
+	libraryVersion++
+}
+
+// annotationsHandler serves /annotations, which lists the annotations
+// of a file on GET and adds one on POST, and /annotations/delete.
+func annotationsHandler(w http.ResponseWriter, r *http.Request) {
+	file := r.URL.Query().Get("file")
+
+	var list []Annotation
+	var err error
+	switch {
+	case r.Method == http.MethodGet:
+		libraryLock.RLock()
+		if e := library[file]; e != nil {
+			list = e.Annotations
+		} else {
+			err = fmt.Errorf("no such file %q", file)
+		}
+		libraryLock.RUnlock()
+	case r.Method != http.MethodPost:
+		http.Error(w, "changing annotations requires a POST", http.StatusMethodNotAllowed)
+		return
+	case strings.HasSuffix(r.URL.Path, "/delete"):
+		var id int
+		if id, err = strconv.Atoi(r.FormValue("id")); err != nil {
+			err = fmt.Errorf("bad annotation id")
+			break
+		}
+		list, err = deleteAnnotation(file, id)
+	default:
+		var a Annotation
+		if err = json.NewDecoder(r.Body).Decode(&a); err != nil {
+			err = fmt.Errorf("bad annotation: %s", err)
+			break
+		}
+		list, err = addAnnotation(file, a)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if list == nil {
+		list = []Annotation{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		log.Printf("annotationsHandler: encode error: %s", err)
+	}
+}
+
+// positiveTerms returns the leaves of a query that aren't under a
+// NOT, which are the terms that can explain why an entry matched.
+func positiveTerms(n queryNode) []queryNode {
+	switch n := n.(type) {
+	case *andNode:
+		return append(positiveTerms(n.left), positiveTerms(n.right)...)
+	case *orNode:
+		return append(positiveTerms(n.left), positiveTerms(n.right)...)
+	case *notNode:
+		return nil
+	}
+	return []queryNode{n}
+}
+
+// matchingAnnotations returns the annotations of an entry that a
+// query matched on, either by their tags or by the words of their
+// label and note.
+func matchingAnnotations(e *LibraryEntry, q queryNode, stems map[string]bool) []Annotation {
+	if len(e.Annotations) == 0 {
+		return nil
+	}
+	terms := positiveTerms(q)
+
+	var out []Annotation
+	for _, a := range e.Annotations {
+		if annotationMatches(a, terms, stems) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+func annotationMatches(a Annotation, terms []queryNode, stems map[string]bool) bool {
+	for _, t := range a.Tags {
+		for _, n := range terms {
+			switch n := n.(type) {
+			case *tagNode:
+				if n.matchTag(t) {
+					return true
+				}
+			case *namespaceNode:
+				if n.matchTag(t) {
+					return true
+				}
+			}
+		}
+	}
+	for _, w := range tokenize(a.Label + " " + a.Note) {
+		if stems[stem(w)] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package main
+
+import "testing"
+
+func TestAddAnnotationsSkipsRepeats(t *testing.T) {
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4", Annotations: []Annotation{
+		{ID: 4, Start: 10, End: 20, Label: "Speech"},
+	}})()
+
+	add := []Annotation{
+		{Start: 0, End: 5, Label: "Speech"},
+		{Start: 10, End: 20, Label: "Speech"},
+		{Start: 10, End: 20, Label: "Music"},
+	}
+	list, err := addAnnotations("a.mp4", add)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 3 {
+		t.Fatalf("got %d annotations, want 3: %+v", len(list), list)
+	}
+	if list[0].Start != 0 || list[0].ID != 5 {
+		t.Errorf("first annotation %+v, want the new one at 0 with ID 5", list[0])
+	}
+
+	version := libraryVersion
+	again, err := addAnnotations("a.mp4", add)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again) != 3 || libraryVersion != version {
+		t.Errorf("adding the same annotations again changed the entry: %+v", again)
+	}
+}
+
+func TestAddAnnotationsIsAllOrNothing(t *testing.T) {
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4"})()
+
+	_, err := addAnnotations("a.mp4", []Annotation{
+		{Start: 0, End: 5, Label: "Speech"},
+		{Start: 9, End: 8, Label: "Speech"},
+	})
+	if err == nil {
+		t.Fatal("no error for an annotation ending before it starts")
+	}
+	if n := len(library["a.mp4"].Annotations); n != 0 {
+		t.Errorf("entry has %d annotations after a failed update", n)
+	}
+}
+
+func TestFormatTimecode(t *testing.T) {
+	tests := map[float64]string{
+		0:       "00:00",
+		65.9:    "01:05",
+		3599:    "59:59",
+		3600:    "1:00:00",
+		36125.5: "10:02:05",
+	}
+	for sec, want := range tests {
+		if got := formatTimecode(sec); got != want {
+			t.Errorf("formatTimecode(%v) = %q, want %q", sec, got, want)
+		}
+	}
+}
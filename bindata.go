@@ -9,10 +9,20 @@
 // static/js/vendor/foundation.min.js
 // static/js/vendor/jquery.js
 // static/js/vendor/what-input.js
+// static/tmpl/audio.tmpl
+// static/tmpl/entry.tmpl
+// static/tmpl/fields.tmpl
+// static/tmpl/image.tmpl
+// static/tmpl/jobs.tmpl
 // static/tmpl/list.tmpl
 // static/tmpl/main.tmpl
+// static/tmpl/namespaces.tmpl
 // static/tmpl/plyr.tmpl
+// static/tmpl/rules.tmpl
+// static/tmpl/search.tmpl
 // static/tmpl/status.tmpl
+// static/tmpl/tags.tmpl
+// static/tmpl/vocab.tmpl
 // DO NOT EDIT!
 
 package main
@@ -80,7 +90,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _staticCssAppCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x01\x00\x00\xff\xff\x00\x00\x00\x00\x00\x00\x00\x00")
+var _staticCssAppCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\xdb\x8e\xa4\x38\x0c\x7d\xaf\xaf\x88\x34\x6a\x69\x57\x9a\x20\xe8\xeb\x4e\x4a\xda\x7f\x09\x60\xc0\x53\x21\x41\x89\xa9\xcb\x94\xe6\xdf\x57\x81\x70\xab\x82\xda\x9e\x56\xd4\xdd\x24\x69\x3b\x3e\xc7\xc7\x4e\x22\x32\x07\xd0\x1c\x75\xd3\x12\xbb\xee\x18\x63\xac\x31\x0e\x09\x8d\x16\xcc\x82\x92\x84\x47\xd8\x77\xeb\x39\xba\x46\xc9\x8b\x60\x85\x82\x73\xbf\xe4\xbf\xf8\xc9\xca\x46\x30\xff\xbb\x5f\x94\x0a\x4b\xcd\x91\xa0\x76\x82\x65\xa0\x09\x6c\xbf\x51\xa3\xe6\x15\x60\x59\x91\x60\xcf\xd1\xeb\xcb\xc7\x9b\x85\x3a\x6c\x49\x5b\xa2\x16\x2c\x66\x31\x4b\xc6\xd5\x46\xe6\x39\xea\x52\xb0\x38\x7a\xf6\xff\xcb\xe2\x68\x32\x49\x8d\xcd\xc1\x0a\x96\x34\x67\xe6\x8c\xc2\x9c\x7d\xcb\xa4\x1f\x61\x5b\x66\x87\xd2\x9a\x56\xe7\x3c\x33\xca\x58\xc1\xbe\x15\xe0\xc7\x60\x7d\xe6\xae\x92\xb9\x39\x09\x86\xda\x01\xf9\x83\x9b\x33\x7b\x6e\xce\xcc\x96\xa9\xfc\x2b\x89\xbf\xb3\xe1\x27\x8e\x92\xbf\x7b\xb3\xac\xb5\xce\xfb\x22\x38\xd3\x7e\xf7\x7b\xb7\x5b\xf0\xf7\x2f\x9b\xf3\xe8\xc9\x11\x2c\x99\xb0\x9f\x30\xa7\x4a\xb0\x7f\x46\x08\x03\x19\xb2\x25\x73\xc3\xc3\x3a\x01\x43\xec\x3d\x72\x6d\xf4\x0a\x9a\x7e\x75\x23\x34\x51\x98\xac\x75\xec\xfa\x65\x3f\xfd\x84\x5d\x97\xd1\x46\xc9\x90\x9f\xe1\xef\xb0\xb0\xe5\x81\x5b\xa8\xcd\x11\x16\x8e\xb8\x82\x82\x7c\xb2\x5f\x47\xa8\x21\x75\xa8\x2b\xb0\x48\xcb\x1c\x34\x06\x7b\x6d\x4d\x27\xb8\xb6\x2c\xc1\x79\xf1\xba\x3b\x31\xcb\xd4\x19\xd5\x52\x40\x4a\xa6\x11\x2c\x89\xe3\xa7\x7e\x1a\x4e\xee\x27\xb6\xcf\x4a\x98\xfd\xe2\xa8\xf3\x2e\x93\xf1\x7e\x89\x3a\x98\xa2\x23\xee\xe8\xa2\x60\xc9\xe4\x57\xd5\xb9\x8e\x46\x21\xbb\x6e\x55\xe1\xcf\xd6\x11\x16\x17\x9e\x19\x4d\xa0\x49\x30\xd7\xc8\x0c\x78\x0a\x74\x02\xd0\x9f\x28\xa5\x4f\x32\xaa\x30\x72\xa0\x20\x23\xc8\xbf\xaf\xef\x8b\xca\x1c\xc1\xb2\xeb\x16\x46\x78\xf7\xa3\xc7\x28\xb5\x36\x24\xbd\x25\x4f\xa5\xbd\x4b\xd7\xb2\xf7\x0c\x95\x12\x47\x6b\x4d\xa3\x83\xc2\x9e\xa3\xb7\xa7\x59\xf3\xf8\xb3\xe3\xa3\x5a\xda\x03\xdc\x87\xb1\xa2\x9a\x90\xf7\xd4\x10\x99\x7a\x9c\xce\x0a\xfc\xa5\x39\x6f\x86\x90\x7c\x7c\xfc\x48\x83\x08\x4c\x23\x33\xa4\x4b\x87\xea\x41\x22\xd6\x23\x5d\x50\x3d\x7a\x4a\x7a\x6e\x33\xbf\xc7\xa5\xa5\x5b\xd1\xa4\xca\x64\x87\x81\xbd\xf3\xd8\x8d\x93\xf7\x7b\x52\xbb\xae\x14\xf8\xf4\x3e\x65\x9b\xa3\xe1\x5e\x7b\x60\x1f\xb8\x0d\x1c\xfc\x78\x7b\x5a\xf5\x37\xc8\xce\x7b\x74\x84\x4a\x05\x57\x3e\x9a\x7b\xd3\x29\xc2\x8f\xb7\x63\x15\xac\x40\xda\xac\xe2\x07\xd4\xb9\x5b\xf4\xdb\xe9\xa4\x51\xe3\x93\x5e\xbc\xe5\x49\x1e\xa1\x30\xb6\xfe\x7a\xf4\x0f\xd5\x55\xbc\xf8\xf1\x20\x91\x5d\xa7\xa0\xaa\xad\x53\x2d\x71\x00\xbe\x79\x29\xbc\xce\x0a\x74\x0c\x63\x51\xbc\xd3\x34\xe8\x29\xfd\x09\x19\xf1\x02\x49\xb0\x4e\x01\x9f\x2a\x84\x2e\xaa\xc6\xc2\x11\xe1\x74\x27\xff\x8d\x17\x00\x6a\x85\x1a\xf8\x8c\xb8\x23\x58\xc2\x4c\x2a\xde\xdd\xfe\x82\xd5\x98\xe7\x2a\x74\x33\x47\xc6\x5e\x52\x23\x6d\xce\x0b\x2b\x6b\xb8\xe5\x7f\xea\x9a\x8f\xeb\x6e\x7e\x07\x2e\x1a\xf6\x0c\xa1\x85\x06\x24\xf9\x4e\x1c\x3e\x07\xc7\x5d\x1e\x38\x1c\x41\x93\x1b\x8e\xf4\xa2\x70\x99\x6d\xd3\x3f\xea\x40\xdb\x69\xe9\x3a\x50\xbc\xc9\xfa\xfc\x02\x58\x15\xc8\x14\x4b\xd4\x58\x53\x5a\x70\x8e\x17\x53\x8d\x0c\x21\x4c\xd7\x56\x10\x4f\xfc\xbf\xed\xe6\xc6\xfb\x46\x46\x3a\x9a\xa7\xc7\xc8\xd8\xe1\xe6\x4d\x77\xe5\x62\x8b\xa5\x1f\xf7\x87\x74\x68\x08\x6b\x78\x50\x70\x5b\x19\xbf\xe9\xae\x01\xe8\x84\xfc\xf6\xd6\x5c\xc7\xbf\xf2\x92\x7b\x0f\x2f\xb9\xc2\x68\xe2\x0e\x7f\xc1\x0d\x3e\xff\xb2\x1b\x44\x9c\x81\x26\xb0\xfb\xdd\xef\xdd\x7f\x03\x00\x7f\xca\x66\x8f\x27\x0b\x00\x00")
 
 func staticCssAppCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -95,7 +105,7 @@ func staticCssAppCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/css/app.css", size: 0, mode: os.FileMode(436), modTime: time.Unix(1516936264, 0)}
+	info := bindataFileInfo{name: "static/css/app.css", size: 2855, mode: os.FileMode(436), modTime: time.Unix(1792400531, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -160,7 +170,7 @@ func staticIndexHtml() (*asset, error) {
 	return a, nil
 }
 
-var _staticJsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\xd1\x48\xc9\x4f\x2e\xcd\x4d\xcd\x2b\xd1\xd4\x4b\xcb\x2f\xcd\x4b\x49\x2c\xc9\xcc\xcf\xd3\xd0\xe4\x02\x04\x00\x00\xff\xff\x4b\xa4\x47\xb9\x19\x00\x00\x00")
+var _staticJsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x3a\xff\x6e\xdb\x36\xb7\xff\xfb\x29\x4e\x77\xb7\x4a\x6e\x1c\x25\xbd\x03\xfa\x47\x32\xaf\xd8\xd2\xee\xae\x43\x97\x0d\x6d\x86\x3b\xa0\xed\x05\x28\xe9\xd8\x62\x42\x93\x1a\x49\xd9\xf1\x6d\xfd\x1c\xdf\x03\x7d\x2f\xf6\xe1\x50\xa2\x44\xc9\xb2\x93\xc2\x41\x61\x93\xe7\xf7\x6f\x92\xfd\x36\xce\x55\x56\xad\x50\xda\x69\xb2\x50\x95\xcc\x99\xe5\x4a\xc6\xd3\xc9\xe4\xec\x0c\xac\xba\x43\xf9\x46\x96\x95\x05\x5b\x69\x69\x80\x49\x40\x81\x04\x0d\x5c\x5a\x05\x0c\x04\x37\x16\xd4\x02\x34\xae\xd4\x9a\xa5\x02\xc1\xb2\x25\x64\x05\x2f\x0d\x51\xd8\x70\x5b\x10\x16\x77\x44\x16\x4a\x03\xcb\x73\x2e\x97\xb0\x52\x1a\x67\x90\xa9\x55\x29\xd0\x62\x0e\x0b\xad\x56\x70\x66\xd9\xd2\x9c\xf9\xc5\x04\xe0\x8d\x25\x22\x1a\x5b\xee\x2a\xbd\xc5\xcc\x82\x55\xb0\x44\x0b\x4c\xe6\x60\xd0\x82\x2d\x1c\x5b\x93\x4c\xce\xce\x08\xe1\xa6\x40\x50\x25\x29\x62\x60\xc5\xb6\xb0\xe4\x6b\x04\x06\x92\xad\xd0\x94\x2c\xc3\x19\x6c\x0a\x65\x10\xd6\x4c\x54\x68\x80\x69\x24\x12\x12\x30\xe7\x16\x73\x2f\xb7\x22\xb5\x0b\xec\xd0\x2e\xa0\xd4\xb8\xe0\xf7\x33\x60\x90\x29\xa1\xb4\x53\x88\x98\x3b\x7d\x6b\x71\xb8\x5c\x92\x11\x14\x51\x51\x52\x6c\x81\x09\xa1\x36\xa0\x64\xc3\x2e\x99\x2c\x2a\x99\x91\x6c\x81\x79\x63\x14\x33\x2f\xf1\x14\x3e\x4f\x00\xa0\x55\x60\xde\x7e\xfb\xf2\x05\x3e\xef\x2e\xdd\xe6\x9a\xe9\x46\x98\x6e\x3f\x69\x05\x85\x97\x23\x6b\x27\x10\x5d\x44\x70\x01\x51\xd4\x91\x20\xa3\xc1\x1c\x3e\x7c\xea\x96\x6a\x55\xe6\xe0\xc3\x22\xc9\x34\x32\x8b\xaf\x6b\xb7\xc7\x91\x29\x99\x8c\xa6\x1d\x7c\xed\xd9\xc3\xf0\x6e\x3f\x44\x70\x11\x73\x18\xbe\x12\x21\xb0\x41\x81\x99\xc5\x1c\xe6\x70\xfa\xbc\x5b\x2e\x51\xba\x28\x9a\x83\xac\x84\xb8\x9c\xb8\x0d\xc7\x29\xb1\xdb\x12\x61\x0e\x91\xc5\x7b\xdb\x68\x5a\x6f\x18\xb4\x3f\x59\xab\x79\x5a\x59\x8c\x23\x56\x59\xe5\x03\x2d\x9a\x41\xa4\x16\x0b\xcf\x97\x04\x4c\x32\xc1\x8c\xb9\x66\xab\x9a\x16\x39\xea\xd4\x54\xcb\x25\x1a\x67\xea\x86\x30\x8a\x1a\xee\x2d\x61\xb0\x3c\x8f\x1b\xc8\x9e\xce\x28\x12\x56\x92\xc0\x57\x05\x17\x79\xec\xec\x3b\xbe\xe5\xd0\xc6\xb7\x48\xa6\x69\xa3\x67\x1b\x3f\x1a\x65\x8e\x3a\xf6\x01\x43\x1f\x47\x3d\xe1\x52\xa2\xfe\xf5\xe6\xf7\xb7\x24\x7c\x23\x2b\xfd\x91\xbb\x93\x85\xd2\xaf\x59\x56\xc4\x9e\x4c\x6c\xd9\x72\x06\x3c\xa4\xe2\xcd\x4c\xd4\x1e\x19\x0a\xfe\x43\x28\x23\xc6\x03\xc1\x52\x14\xd1\x08\x2c\xf9\xe9\x4a\x49\x4b\x35\x65\x4e\x59\xdc\x87\xe1\x0b\x88\x7d\x2c\xbb\x9c\x1b\xca\xd9\x12\x32\x76\x2b\x30\x49\x59\x76\xb7\xd4\x54\xc8\xae\x08\x3a\xc8\x0e\x87\xdd\x27\xbe\xeb\xfd\x72\x1a\x0b\xaa\x0b\x87\x55\x66\x7b\xfa\x12\xc2\x58\xb4\xb8\x82\x88\x43\x8d\x1d\xb4\xe5\x56\x10\x93\xe8\xdd\x11\x98\x9e\x59\xa2\x7f\xff\x6b\x14\x4a\xc9\x4c\xf0\xec\x0e\xe6\xd0\x7a\x73\xcc\x3e\xce\xef\xa6\x14\x3c\xc3\x98\xcf\xe0\xf9\x40\x07\xfa\xf3\xb1\xd4\xdf\xd9\xf5\x7f\x3a\x3b\x87\x61\xe9\x64\x1d\xe0\x10\x90\xe9\x05\x2f\xad\x04\x40\xbb\xe6\xfb\x6e\x10\xce\x94\x40\x96\x2d\x43\x05\xa8\x97\xb8\xa0\x48\xac\xe6\xab\x50\x3a\x72\x96\x50\x1b\xd4\x7e\x5f\xbd\xa5\x5f\x57\xcc\x60\x08\x46\xe1\x43\x44\x9e\x3e\x85\x27\xb5\x15\xd4\x0a\x83\xd0\x9f\xc2\xe7\xa6\xbd\x80\xed\xd3\x80\xf9\x7c\x5e\x73\xb8\x84\xdd\x74\x68\xd5\x30\x2c\xeb\x92\x7f\xc8\xee\x41\x79\x1d\x0f\x3b\x02\x4a\xca\xca\x14\x94\x88\x81\xe8\xe3\x5e\xe9\x70\x5d\xb5\x48\x5c\x4f\x19\xa4\x79\xc1\x73\x8c\x0f\x18\xb9\xde\x0b\x64\xa5\xca\x72\xb0\x60\xb8\xcd\x3a\xb1\x72\x6e\x4a\xc1\xb6\x04\x20\x95\x0c\x83\x76\xa4\x46\xef\x73\x5d\x16\x82\x2f\x0b\x1b\xf7\xea\x0c\x39\x91\x5b\x5c\x51\xbf\x71\xac\x32\x8a\x17\x8d\xb2\x23\x4e\x86\x76\x20\x89\x40\xb9\xb4\x85\x73\xcb\x79\x48\x84\x3e\xb5\x0b\xc7\xac\x14\x08\x17\x73\x38\x81\x90\xd6\x14\xbe\xeb\xfd\xee\xf0\xa9\xaf\xc7\x24\xdd\x2d\xcc\xe1\xfc\x12\x6e\xe1\x87\x3e\x24\xdc\x9e\x9c\x0c\x85\x70\x00\x1f\x6e\x3f\x05\x3d\xc1\xaa\xe5\x52\x60\x1c\x79\x29\xa2\x19\xdc\x3a\x0d\xfc\xc2\x9e\x67\x87\x96\xf3\x3d\xaa\xe7\x33\x92\x8c\xfa\x1c\xb5\xc5\x20\x0c\xf6\x92\x84\x8c\xd7\xb4\xca\xa1\xb0\xcd\x72\xc2\x52\xa5\x6d\x3c\x1d\x33\x1d\x61\x3f\x71\x6c\x86\xc8\x61\x80\x3d\xec\x02\x92\xf6\xbe\xa0\x44\x95\xb8\x81\xbf\x7f\x7f\xfb\xab\xb5\xe5\x3b\xfc\xa7\x42\xd3\xe3\xdc\x88\x04\x73\xb8\x2f\x74\xb7\x7c\x5f\xe8\x44\xa3\x29\x95\x34\x78\xd3\x34\xf7\x5b\xa3\x64\x10\x81\x04\xa2\xa4\x46\x96\x6f\x8d\x65\x16\xb3\x82\xc9\x25\x1e\xab\x8a\xa4\x1b\x61\x39\x9c\xf7\x84\x03\x4f\xe6\xf3\x81\x70\xc9\xab\x3f\xae\x5f\xc3\x97\x2f\x24\x4f\x42\x84\x2b\xe3\xa0\xfe\xfb\x7c\x2f\x00\xc7\x2c\xd0\xb7\x42\x5f\xc3\x7a\x6c\x79\xc8\xa6\x64\xb9\x82\xad\x49\x13\x57\x25\x56\xac\x7c\x54\xed\xa2\x8a\xd5\xa7\xd4\x28\x5b\x5b\x91\x54\xfa\xf0\x69\xba\x3f\x09\x64\x63\x6a\x35\x73\x22\xcc\x21\x4b\x6e\x86\xed\xd9\xdb\xb2\x9e\x45\xc7\xd0\x3d\xc4\x5e\x91\x4e\xb8\xcc\xf1\xfe\x8f\x45\x8b\xfb\x64\x2c\xb3\x1f\x32\xf0\xb8\xa1\xf7\xfb\x87\xa9\x52\x63\x35\x97\xcb\x86\x5d\x93\xc8\x03\x33\x8d\x53\x22\xf1\xc9\x0d\xad\xc4\x7b\xba\x4c\xe1\xc7\x23\xb2\x1f\x92\x7b\x9f\x93\x2f\x88\x47\x26\x10\xc1\x87\x23\x88\xaf\x3d\x0f\x8d\x52\x9e\x41\xa6\x2a\x69\xbf\x72\xac\xa3\x3f\x87\xd7\x1f\x75\x52\x96\x2f\x11\x0c\x66\x4a\xe6\x4c\x6f\xa3\x43\x48\x7d\xc9\xb2\xe4\x8a\x56\xf7\x81\x9d\x16\xbd\xc9\x81\xe0\x0e\xa9\xab\xe4\x4a\x55\x06\x73\xb5\x91\x61\xaa\xe3\xfa\x90\x1f\x70\x9d\x94\x1a\xd7\x28\xed\x2b\x5c\xb0\x4a\xf4\xaa\x4f\xf8\xf1\x93\xc8\xfe\xee\x60\x20\x6a\x7b\x64\x28\x34\x69\x31\x40\x1d\xa6\x23\x45\x54\xaf\xe1\x35\xe1\x08\x3f\x8e\x47\xd1\x78\x1f\x4e\x85\xca\xee\x06\x36\xdf\x4d\x46\x44\xa5\xe4\x57\x25\xca\x38\xfa\x9f\xd7\x37\x74\xda\xe9\x1f\xb4\x5f\x0a\xbe\xe2\x76\xfe\xfc\xfc\x69\x9d\x1b\xf3\x08\x4e\x00\x65\xa6\x72\xfc\xeb\xdd\x9b\x2b\xb5\x2a\x95\xa4\xc8\x68\x0e\x9c\x27\x50\x37\x85\x40\x27\x62\x60\x50\xe6\xf1\xb4\xd7\xff\xeb\xde\xc4\xf2\xfc\x35\x19\x9d\x3a\x22\x4a\xd4\xfe\x4c\x38\x1b\x2f\xcf\x64\x9b\xb0\xa9\xf9\xa4\x8b\x66\xd1\x78\x9a\x85\xc0\x34\xe4\x5a\x07\xda\x56\x37\x96\x87\x5d\x76\x2c\x1f\x3b\x9b\x75\xdd\xb6\xd1\x63\x7a\x79\x54\x91\x3b\xdc\x52\xf8\x45\xb3\x43\xe1\x67\x36\xdc\x66\x05\xc4\xb8\x4e\xee\x70\x1b\xee\x64\xcc\x20\x44\x3f\x69\xad\x36\xaf\x88\xc4\x45\xbb\x43\x7f\xdd\xc0\xe4\x27\x05\x38\xd9\x9b\xdb\x53\x8d\xec\xee\x72\x8c\xe2\x5f\xe5\xc3\xf4\x4e\x1f\x47\xef\xb5\xb4\xa8\x03\x6a\xf5\xea\x0d\x4b\x07\x1c\xc8\x6d\x2d\xed\x43\xd5\x90\x12\xab\x17\xf7\x1f\x3c\xca\xa7\x64\xc1\xb5\xb1\x2e\x83\xc2\x42\x36\x10\x71\x07\x28\x0c\xee\xc5\x48\x3d\xf8\xc0\xd3\xa7\x50\x1b\xda\xcd\x58\x8d\xe8\x87\xe4\x08\xf0\xc7\x99\x7c\x9e\x3c\xa6\x90\xef\x1e\x61\x42\x93\xb1\x12\xa3\x8b\x07\xfb\xfd\x28\xf6\xcf\x2c\xbb\x73\xb7\x3a\x23\x06\x0f\x74\xa8\x55\x8e\xc8\x06\x94\xdc\x0f\x14\x14\x07\x52\xaa\x72\x28\xc1\x91\xa3\xe0\xe4\x98\x25\xf2\xba\xa0\x5e\x1c\x05\xda\x4d\x1e\xac\xc4\x0f\x25\x5c\x2a\x2a\xfd\xd8\xc2\x51\x07\xc5\x50\xfb\xc3\xae\xdf\x4d\xc6\x7c\xe3\x25\x42\x31\x22\x8e\x3b\x7d\x1f\xce\x7e\x92\x08\xd7\x89\x65\x9a\x6e\x2b\xc9\x41\x28\x68\xf8\xea\xaf\xd1\xf1\xb8\xbd\xf5\xf3\x9f\x5a\xc4\x85\xca\x2a\x13\xef\xc9\xe8\x65\xf2\x72\x4e\x3a\x6b\x07\x64\xc8\xc7\x17\xe3\xa6\x7a\xbc\xb9\x1e\x91\x2d\xbd\x5f\x7e\x20\xa5\xf8\x32\xee\xc2\x21\x00\xdf\xcd\xda\xaf\x06\xed\x4d\x5f\x3e\x3b\x64\xdd\x9c\x9e\x63\xdb\xce\xab\x5c\x58\xd4\xdd\xb8\x6a\x82\x09\xd8\x34\xf2\xbb\x01\x3d\x8a\xf6\x27\xe0\x43\x47\xe9\xdd\xe5\x64\xe7\x2e\xbb\x17\x4a\xaf\x98\xbd\xe1\x2b\x84\x8d\xe6\x16\x4d\x33\xd7\x18\x60\x06\x56\x17\xc6\xcc\x40\x69\x28\x2e\x56\xf4\xdd\xdd\xfc\x32\x09\x85\xaa\x34\x2d\xd3\x75\x76\x70\xb3\xdb\xd1\x8a\x0d\xb6\x53\x35\x0d\x5f\x74\xd4\xfd\x9d\xd9\x22\x59\x08\xa5\xb4\xdb\x0d\xae\x35\x59\x1e\x4e\x32\x32\xd0\x2f\x96\xf0\x03\x3c\x3f\x87\x97\x10\x9d\xd7\xb7\xb8\x53\x38\x01\x79\xe9\xdb\x3c\x79\xd3\x50\x7b\xfc\xfe\x45\xff\x74\xd2\xe0\x87\x3c\xe1\xac\x81\xaa\xaf\x84\x4f\xa0\x64\x79\x3c\x00\x78\x71\x4e\xa7\xe3\x17\x03\x20\x53\xaf\x35\x99\x11\x86\xdd\x38\x89\x03\xd8\xb5\xc1\x4b\xa6\x0d\x92\x8d\x80\x4e\x61\x06\x18\x58\x6f\x7c\x8b\x12\x58\xeb\x80\x99\x33\x7f\x68\x7d\x26\xf3\xf0\x61\xe0\x9a\x5d\x53\x39\xe4\x16\x32\x26\x23\x1b\x38\xa2\xe5\x11\x53\x4f\x09\x1d\x51\x32\x6d\xc9\x19\xb4\xde\xc4\x8e\x9f\x1e\x2e\xfc\xf4\x4b\x46\x75\x70\x5d\x35\xfd\x9e\x82\x31\xc0\x69\xca\xee\x88\xc5\xaf\xd9\xf5\xa8\x9d\x88\x9c\xc6\xbc\xca\x82\x7b\x28\x83\xd9\x0c\xca\x11\x22\x06\x33\x78\x06\x2f\xce\xe1\x04\xe2\xb3\xff\xfb\x98\x9f\xc4\x1f\x93\x8f\xf9\xb3\xe9\xcb\x6f\xcf\x12\x4b\xe7\xe7\x72\x0a\x2f\x89\xa8\xc1\x5f\x84\x62\xee\xf7\x05\x5c\xb3\x6b\xef\xa3\x19\x74\x06\x7f\x87\x6b\x64\xc2\xbd\x69\x6c\x98\x96\x5c\x2e\x0d\x50\xb0\x72\x81\xa6\x79\x11\xa1\xc9\xce\x01\xa4\x5a\x6d\x0c\xea\xda\xa0\x40\x13\x67\x32\x69\x0f\x0c\xff\x54\xa8\xb7\xef\x5d\xe7\x56\xfa\x27\x21\xe2\xe8\x43\xce\x2c\x3b\xad\x24\x01\xd2\x2b\xd0\xa7\x60\x00\x6b\xb5\x44\xe1\x35\x74\x0e\xd0\x2a\x3d\x76\xd5\xba\xe6\x39\xaa\x9e\x27\x08\x21\xc9\x98\xfc\x53\xb0\x2d\xdd\x04\xc4\x28\x12\xe2\x6b\xd0\x26\x1d\xeb\x31\x97\xa0\xd8\x9f\x9e\x9b\xc1\x79\x37\xa1\x4a\x41\xe6\x31\x56\xe9\x6d\xaa\x98\xce\x0d\x64\x2c\x2b\xd0\x38\x4b\x84\xcb\x0b\xb4\x59\x41\xaf\x55\x14\x8b\x6a\x8d\xa4\x05\xae\x39\x6e\xcc\xac\x7d\xed\x5a\x30\x1a\x1e\xa8\x3e\x38\x0d\x88\x08\xb3\x40\xe7\x47\xa0\x8b\x33\xd8\xa2\x4d\x26\x64\x80\x90\xf0\xdc\xbd\xec\x10\x0d\xa1\x58\xfe\xbe\xdd\x81\x8c\x09\x61\x20\x27\x44\x47\xbd\x2f\x11\xbd\xbc\xb1\x9a\x0f\x28\x99\x21\x70\xf7\x60\xc6\x0d\xb0\x35\xe3\x82\xcc\x11\x24\x43\x9f\x74\xbc\xe0\x02\x67\x8e\xb4\x37\x15\x59\x99\x56\x81\xcb\x50\xba\xd0\x92\x04\x12\x6c\x7d\x20\xf0\x4f\x21\x00\x7d\x88\xe6\x08\xd4\xb0\xf4\x76\x71\xee\x5d\x41\xff\xee\xe1\x51\x49\x24\x9b\x5e\x4e\x1e\x7b\x9b\xb4\x7f\xde\xe9\x88\xbe\xac\x6b\xf3\x9c\xae\x90\x9e\x12\xfd\x43\xa7\x1d\xda\x9b\xce\xc0\xea\xb6\xe3\x7d\xdd\x35\xd3\xc8\x15\xd3\x7c\xfc\x8a\xe9\xd0\xe5\x54\x73\xe5\x34\x3f\x7c\xe5\x34\x66\xab\xdf\xde\xff\x71\x9d\xb8\x8a\xd0\xbb\xf4\xb9\xa1\xfa\x77\x39\x19\x10\x78\xd8\x55\x47\x07\x62\x7a\x6b\xd5\x5b\x60\x4b\xc6\x25\x08\x66\x51\xcf\x80\x5b\xf7\xe6\x6a\x2c\x17\x02\x52\x84\x14\xe9\xca\x6b\xc5\x72\x4c\xf6\xf0\x73\xa4\xd3\xd6\xbe\xcb\x07\xec\xf7\x5b\xf6\xf0\xd0\x59\x17\x38\x53\xa8\x4d\x17\xe0\xbf\x68\x7a\xbe\x5b\xb1\x3b\x34\x40\xef\xab\x1b\x9e\xdb\x02\x4a\x7e\x8f\xc2\xc0\x86\xe7\x38\x73\x08\x2e\xc9\x17\x0e\x56\x2d\x88\xca\x20\xc5\x24\x32\x8d\xc6\xbd\x39\x5b\xdf\x91\x82\xa4\x1a\xe1\xe9\x5e\x73\xc9\x8e\x33\x30\xe9\x0c\x6c\xc3\xda\x3b\x90\x22\x98\xfb\x31\x60\xc5\xee\xe3\xf3\x59\xf3\x9d\xcb\xd8\xa4\xf5\xe5\x08\x9c\xc2\xf3\x66\xb9\x9e\x14\x2c\x9c\x81\x49\x93\x37\x74\xa6\x59\x33\x31\xf5\xc7\x6f\xa2\x66\x32\xe6\x1e\x94\x1c\x9b\x1a\xee\x7f\xe9\x6b\x07\x41\xd5\xdc\xb3\xcc\x90\x8b\x8e\x8f\x83\xbe\x52\xa2\x5a\x49\xd3\x90\x6c\xab\x65\x4d\xcf\xd3\x3d\x81\xa8\xbc\x8f\x06\x20\x05\xd2\xa9\x12\xe6\x44\xe5\xd7\xfa\xfb\xb3\x46\x9e\x51\xf8\xee\x7d\xee\xcd\x8a\xb9\xe4\x89\x2a\x2d\xe2\x6f\x7a\x19\xfa\x50\x56\xd2\x54\xf1\xcd\xf4\x30\xe9\xf7\xfc\xff\x89\x72\xa7\x18\x3c\x6b\x6d\xd2\x17\x0f\x88\x8d\x33\xce\xb3\xaf\xd7\xe0\x4f\x65\xb8\x8b\x81\x39\x9c\xc6\x1c\xbe\x0b\x2d\x79\x94\x63\x1b\xcf\xa7\x81\x83\x79\xdf\x15\x47\x05\xaa\xc3\xfd\xfd\x1d\x5f\x81\x2d\xb4\xaa\x96\x85\x6f\x36\xe9\x16\x56\x6a\x4d\x19\xe7\xda\x93\x2d\x90\xd3\x7f\x51\xa8\x56\xa9\x64\x5c\x98\xa3\x4d\x3c\xa1\xa3\xf9\x69\xd3\xd1\xea\x8e\xbe\xe0\x8f\xea\xe5\x75\xfa\xd0\xf1\xa6\x4f\x36\x8e\x92\xce\xaf\xa7\x0e\x2a\x3a\x76\x9e\x72\x17\x7a\xee\x4d\x34\x3c\x53\x79\x4e\xf4\x19\xf4\xb0\x60\x02\x20\x51\x03\x2c\x93\x86\x68\x6d\x1e\xd4\x42\x2e\xd1\xfe\x4c\x81\xc2\xe5\xf2\x4a\x70\x94\xf6\x1d\x66\x6d\xf7\xf0\x1f\x82\x67\x74\x5f\x19\x63\x92\x39\xa8\xbf\xe1\x14\x74\x22\x70\x61\xa7\x70\x06\x3a\xd9\x78\xf7\xfa\x64\x7a\x16\xa6\x68\x9f\xda\x58\x9d\x70\x06\x99\xc1\x9e\x12\x54\x35\x98\x9d\x79\x0e\x03\xb9\x1c\xd6\xfe\x3c\x33\xbc\x0d\xf4\x07\xa0\xdd\x83\x06\x17\xc8\xfa\x16\x0f\x2d\x77\x80\x5b\xf0\x06\x48\x0c\xfc\x0c\xb5\x61\xdc\xfe\xa2\xf4\x6f\x2a\x85\x52\xd1\xe4\xc2\xa0\xcb\x17\xb8\x55\x29\x54\xd2\x72\x41\x4d\x62\xc1\x25\x37\x05\x9a\x99\x9b\x71\x28\x62\xa9\x17\x11\x91\x7a\x94\xdf\x28\x7d\x87\x79\x50\x69\x3b\xda\xf1\xad\x4a\xf7\x27\x97\x5b\x95\x26\xef\xbb\x96\x19\xd1\x7e\x6f\x0a\xa4\x05\x42\x9d\x5e\x1e\x19\x3f\xc6\x28\x2d\x18\x17\x98\x47\x34\xfa\x0f\xb7\x32\x26\x33\xa4\xcd\x90\x11\x13\xa8\x6d\x1c\x91\x15\xfe\x8b\x0a\x0c\x21\xbd\x79\x45\xd9\x0b\xfe\x67\x43\xe3\xa4\x66\xf6\x5a\x6b\xa5\xe9\x6c\x77\xf1\x51\x7a\x88\x7a\xcd\x9d\xf4\x8e\x0a\x6c\xd0\x1d\x5a\x55\x65\xbb\xec\x0c\x85\x79\xcc\xc0\x34\x3e\x34\xdd\xaa\xd4\xf4\xc6\x25\x9e\xcf\xbd\x74\x6f\x5e\xf5\x46\xa3\xaf\x1f\x8f\xbe\x6e\x44\xa2\x6b\xad\xc7\x8d\x44\x41\x94\x1c\x9b\x84\x9a\xf0\xe9\xa4\xef\x0c\x1a\x8c\x18\xc3\x31\xa3\x39\x52\x3d\x3f\x3f\x3f\x9f\x5e\x4e\x76\x93\xff\x0c\x00\x6d\x18\x80\x77\x3e\x27\x00\x00")
 
 func staticJsAppJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -175,7 +185,7 @@ func staticJsAppJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/js/app.js", size: 25, mode: os.FileMode(436), modTime: time.Unix(1516936264, 0)}
+	info := bindataFileInfo{name: "static/js/app.js", size: 10046, mode: os.FileMode(436), modTime: time.Unix(1792399441, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -260,7 +270,107 @@ func staticJsVendorWhatInputJs() (*asset, error) {
 	return a, nil
 }
 
-var _staticTmplListTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x51\xc9\x4e\xc3\x30\x10\xbd\xf7\x2b\x9e\xac\x70\xa3\xcd\x09\x21\xa5\x4e\xb8\xf1\x05\x15\x77\x13\x8f\xdb\x11\xae\x8d\x6c\xd3\xaa\x1a\xf9\xdf\x51\xba\x10\x16\x31\x17\x2f\x6f\xd1\x3c\x3d\x11\x58\x72\x1c\x08\x6a\x8c\xa1\x50\x28\x0a\xb5\x2e\xf4\x6b\x42\x3b\x2c\xb4\xe5\x03\x46\x6f\x72\xee\xd5\x68\x92\x55\xc8\xe5\xe4\xa9\x57\x47\xb6\x65\xd7\xe1\xf1\xe1\x6e\x8d\xbd\x49\x5b\x0e\x1d\xcc\x47\x89\x6b\x35\x2c\x00\xe0\xb7\x70\x69\xf9\xc0\x96\xd2\x15\x9e\xe6\x85\x2d\xc5\x8c\xb7\x10\x8f\x01\x25\x62\x63\xb6\xa9\xbb\x88\x5b\xcb\x87\x7f\x7c\x32\x8d\x85\x63\xf8\xe6\xa3\xa3\x9f\x1f\xd3\x88\x2c\x91\x4c\xd8\x12\x1a\xbe\x47\xe3\xd0\xf5\x58\xd5\xfa\x83\xa3\x3d\x0f\xda\x60\x97\xc8\xf5\xaa\x7d\xf7\xe6\x44\xe9\xc9\xb1\xa7\x5e\xa4\x71\xab\x67\xf6\x14\xcc\x9e\x6a\x55\x83\x08\x3b\x34\x6e\xb5\xe1\xe2\xa9\xd6\x33\xfe\x75\x27\x9f\x6f\x7f\xb3\x46\x84\x82\xad\x55\xb7\x66\xd0\xad\xe7\xbf\xdb\x9d\xe1\x39\x40\x7b\x4b\x70\x0d\x7e\x3b\x2e\x1d\x88\x4c\xfc\xa9\x94\xcf\x00\x00\x00\xff\xff\x45\x7a\xd2\x70\xac\x01\x00\x00")
+var _staticTmplAudioTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\xc1\x6e\xdb\x30\x0c\xbd\xe7\x2b\x08\x01\x43\x2f\x8b\x7d\x1a\x06\xb4\x76\x06\x0c\xdb\xb0\x01\xed\x29\xfd\x01\xc6\x62\x1c\xa1\xb2\x14\x48\x74\xb2\x40\xd0\xbf\x0f\x74\xec\x38\xc9\xba\x45\x39\x50\xe6\xe3\x23\xf5\x44\x2a\x25\xd0\xb4\x35\x8e\x40\x35\xde\x31\x39\x56\x90\xf3\xa2\xda\x04\x28\x57\x8b\x4a\x9b\x03\x34\x16\x63\xac\x55\x83\x41\x2b\x88\x7c\xb2\x54\xab\xa3\xd1\xbc\x7b\x84\xcf\x9f\x3e\x3c\x41\x87\xa1\x35\xee\x11\xb0\x67\xff\xa4\x56\x0b\x00\x80\xfb\xc0\xa5\x36\x07\xa3\x29\x8c\x6e\xf9\xa7\x64\xb6\x50\xbc\x1a\xb6\x94\x73\x4a\xb3\x45\x36\x9e\xbf\xfc\x30\x96\x1c\x76\xc3\x86\x9c\xce\x79\x88\xad\x4a\x6d\x0e\xff\xc8\x12\xa9\x61\xe3\x1d\x30\xfd\xe6\x65\x43\x8e\x6f\x32\x56\xa6\x6b\x2f\x70\x7f\xa0\xb0\xc4\xc0\x0a\x62\x68\x6a\x55\xf2\xae\xef\x36\x0e\x8d\xfd\xb2\x35\x96\xea\x9b\xf4\x0a\xd0\x72\xad\x14\x78\x47\x21\xf8\x50\x2b\xde\x99\x58\x0c\x5a\x14\xda\xc4\xbd\xc5\x13\xd4\xf0\xe0\xbc\xa3\x07\x05\xe5\x55\x4a\xec\xb5\xf1\x60\x74\xad\x04\x44\x41\x81\xc8\x1c\xbc\x15\x45\x47\x4b\x4d\x45\x0d\xe0\xe5\x08\x9c\x49\x64\x55\xd1\xf7\xa1\xa1\x73\xb1\x29\x15\xeb\x61\x9b\xb3\x9a\x74\x3c\xed\x29\x67\x3e\xed\x69\x70\x9f\xb7\x6a\xd4\xed\xba\x22\x59\x29\x2d\x21\xa0\x6b\x09\x8a\x75\xbf\x61\x11\x3e\xe6\x7c\x03\xa9\x38\x60\xf3\x06\x6f\xc6\x69\x21\x94\xab\x5a\x7f\xfb\x99\x73\x83\x7b\x51\x38\x4e\xd7\x14\xa7\xf8\x31\x95\x02\x8b\x1b\xb2\x12\x53\x3c\x8b\x25\x25\x0e\x45\x97\x17\xe8\x8d\xc2\xf3\x11\x9e\xd1\xb5\x39\xc7\xd0\x58\x74\xed\x48\xe0\xda\xff\x9f\x62\x6e\x0b\x59\x55\x39\x28\x38\xc3\x04\xc2\xd4\xed\x2d\x32\x81\xea\x48\x1b\xfc\x8a\x21\x2a\x28\xae\xa2\x04\x24\xf9\x5f\x7e\xbd\x7c\xbf\x26\xbb\x69\x2e\x6b\x7d\xcf\x70\xc4\xe0\x8c\x6b\x15\x68\x64\x5c\xf6\x4e\xae\x0a\x37\x32\x10\x29\x8d\xf1\x97\x11\x19\xfb\xe2\x11\xa4\x2b\xa6\xb9\x98\x7e\xaf\x3b\x13\x61\x13\xfc\x31\x52\x80\x06\xdd\x03\x83\x70\xc1\x85\x06\x44\xa3\xf8\x11\x2a\x84\x5d\xa0\x6d\xad\x4a\x99\x1f\xbf\x94\xcf\xe5\x5d\x73\x6a\x7f\x74\xd6\xa3\x5e\x4d\x06\xf0\x8e\x06\x82\xaa\xc4\x15\xb0\x07\x6b\x22\x93\x13\xcb\x70\x31\x9f\x70\x9e\xa4\xbf\xf5\xbc\x72\xde\xab\xc8\x28\xc7\x9f\x45\xbc\xf5\xb3\xe9\xc8\x1a\x47\x67\xff\x48\x33\xbe\x27\x8b\x94\xde\x61\x5a\x37\xc1\xec\x59\x41\x21\x2f\x4f\x4a\xef\x70\xdd\x23\xc8\x69\xc8\x79\xf1\x67\x00\x04\x47\x3e\xed\xbd\x04\x00\x00")
+
+func staticTmplAudioTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplAudioTmpl,
+		"static/tmpl/audio.tmpl",
+	)
+}
+
+func staticTmplAudioTmpl() (*asset, error) {
+	bytes, err := staticTmplAudioTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/audio.tmpl", size: 1213, mode: os.FileMode(420), modTime: time.Unix(1792404272, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplEntryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x7c\xef\x72\x1b\x37\x92\xf8\x77\x3d\x45\x67\xb6\x7e\x99\xa1\x4d\x0d\x65\xef\x66\xff\x88\xa4\x52\x89\x2d\xff\xe2\x94\x63\xa7\x2c\xe7\x76\xaf\xbc\xfe\x00\xce\x80\x22\xa2\x21\x30\x01\x40\x51\x3a\x86\x55\xf7\x0e\xf7\x86\xf7\x24\x57\x8d\x01\x66\x80\x99\x21\x45\x3a\xc9\x25\x9b\x23\x59\x25\x12\xe8\x6e\x74\x37\x1a\x8d\xee\x06\x46\x9b\xcd\xe8\x11\xbc\x5b\x50\x28\x89\xd4\x0a\xc4\x1c\x34\xfe\x28\xc8\x3d\x95\x50\x92\x6b\xaa\x40\x2d\x88\xa4\x39\xcc\xee\x81\x14\x05\xdc\x30\x9e\x1b\xb8\x25\xcd\x19\x49\xe1\xd1\x68\xbb\x3d\x39\xd9\x6c\x20\xa7\x73\xc6\x29\x44\xa6\xfd\x4b\x22\x55\x04\xdb\xed\x09\xd8\xd7\x24\x67\xb7\xc0\xf2\x69\x44\x38\x17\x9a\x68\x26\xf8\x97\x44\x46\x90\x15\x44\x29\xbf\xf5\x74\x46\x64\x74\x31\x19\xe5\xec\xf6\xa2\xc6\xde\x6c\x4e\x81\xcd\x61\x4d\x6e\xe9\x5c\xc8\xa5\xba\xe4\x64\x56\xd0\xdc\xa7\x9f\x11\x7e\x4b\x94\x19\xc2\x81\xd5\xd4\x9b\x86\x05\x65\xd7\x0b\x3d\x8d\xfe\xfc\xa7\x08\x94\xbe\x2f\xe8\x34\xca\x99\x2a\x0b\x72\x7f\x0e\x5c\x70\x3a\xc6\xa1\x2b\x52\xe1\xe8\x94\xe3\x68\x9b\x0d\x50\x9e\x43\x47\x62\x4d\x72\xa2\x49\x2d\x70\x2d\x2c\xf6\xcc\xc4\x5d\xcd\x48\x46\x64\x7e\xaa\x68\x86\x82\x46\xcd\x00\x13\x64\xce\x20\x5c\x53\x4e\x25\x29\xbe\xa1\x9a\x78\xfd\xf8\x99\x14\x64\x46\x8b\x8b\x77\x4c\x17\xf4\x3c\xe8\xc1\xcf\x84\xf1\x72\xa5\x41\xdf\x97\x74\x1a\x69\x7a\xa7\x23\x43\x4e\x23\x74\x04\xa3\x16\xa9\x51\x45\x2b\x6c\xac\xda\x9e\x13\xfd\x10\xf9\x9c\x68\x5a\x91\xaf\xbe\x1d\x43\x9d\xaa\x4c\xb2\x12\xc5\xef\x19\x04\xf9\x26\x92\x92\x8a\x76\x03\x1a\x41\x26\x0a\x35\x8d\xfe\xf6\xd7\x08\xa4\x58\xab\x69\xf4\x59\x04\x17\x93\x91\x83\x3f\x60\x7c\x9c\x42\x49\xf8\x35\x05\x4e\x96\x54\x95\x24\xa3\x6a\xbb\xed\x63\x71\xb3\x49\x5f\x93\x25\xdd\x6e\xcf\xfb\x05\xc1\xa9\xb5\xb3\xa9\xc9\xf5\x69\x4d\x2e\x02\x34\x81\xe6\xf7\x34\xaa\x29\x75\xcc\x39\x34\xaa\x1e\x26\xde\x91\x6b\xb5\x67\x7c\xd4\x8f\x26\xd7\x6a\x27\xe1\x4a\xd4\x6c\xa5\xb4\x58\xbe\x60\xb4\xc8\x1f\x14\x36\xe8\x75\x64\xd8\x1c\xe8\x0f\x90\xbe\xbb\x2f\x29\x44\x94\xaf\x96\x51\x8b\x0c\x7e\x26\x8a\x16\x34\xd3\x95\xf8\x73\x1c\x2c\x10\xbd\x03\x8f\x9f\x89\x30\x36\x00\xb7\xa4\x58\xd1\x69\x84\x72\x54\x2d\x17\x27\x3d\xd0\x9e\x48\xe9\xbf\x21\x46\x5b\x9a\x1d\x64\x37\x9b\x74\xbb\x8d\x50\xc8\xed\xf6\xe1\x01\xba\x93\x81\xef\xc9\xa8\x12\xaf\x8b\x67\x70\x0a\x45\x43\x2d\xcd\x84\x28\x28\xe1\xbf\x92\xa2\x5a\xe0\x5a\xae\x68\x74\xf1\xef\x54\x1d\x85\x35\x27\x85\xa2\xd1\xc5\x6b\xb1\x1b\xeb\x58\xad\xf0\xd5\x72\x46\x65\xaf\x52\x7c\xcf\x62\xc1\x40\x69\x5a\xe2\x9e\x70\x1f\xed\x50\x16\x8c\x0e\x1d\xd9\xb8\xa8\x87\xc6\x35\x40\x3f\x79\xa8\x95\x2c\x1e\x94\x10\x61\x3e\x62\xa0\x87\xc8\xa2\x27\x3c\x9a\x6e\xd7\xf9\xf4\x39\x9c\x2e\xe8\x64\x84\xdb\x55\x03\x35\x99\xad\xb4\x16\xdc\x79\x45\xfb\xab\x94\x6c\x49\xe4\x7d\x04\x82\x3f\x2b\x58\x76\x33\x8d\x14\xe5\xf9\x0b\x21\x97\xc9\x20\xba\xf8\xae\x44\x9d\x4f\x46\x15\x70\x45\xcb\xfa\xb2\xfe\x3d\x56\xb3\x25\x2d\x18\xa7\xe1\x1e\x6b\x87\x34\xdb\x6a\xce\x6e\x59\x4e\xa5\xb7\x90\x9e\x2d\x48\xa9\xa9\x54\x3e\xf5\x5e\xd4\x9e\x1d\x59\x14\x66\x17\xca\x2c\x09\xe3\xa1\x8a\xbe\x1d\xdb\x42\xa0\x60\x28\xab\x5a\xcd\x96\x4c\x4f\x23\x45\x6e\xa9\x1b\x3f\x19\x8c\x41\x52\xbd\x92\x1c\xcc\xda\x1a\x47\x17\x7d\xce\xf8\x0d\xa7\x60\xa9\x41\x49\x25\xa0\xb8\x43\x20\x0a\x26\x99\xc8\xe9\xc5\xd9\xd9\xf9\xd9\x19\x98\xfd\x7f\x32\x32\x2d\x0f\x6d\xa1\x96\xd8\x2b\xa6\xb4\xb7\x71\x96\x05\xc9\xe8\x42\x14\x39\x95\xd3\xa8\x22\xfa\x92\x6b\x29\xf2\x95\x51\xc2\xa7\x7f\x78\x72\x36\x3e\xfb\xe3\xf9\x93\xa7\xf0\x45\xc1\x32\x0a\xaa\xa4\xe4\x46\x45\xc7\xed\xb7\x81\x75\x56\x3a\xa9\x83\xa0\x6a\xce\x23\xe7\x6e\xae\xc8\x6d\x2d\xb7\xea\x58\x6b\x40\xc8\x61\x06\x84\x40\xd1\x4c\xf0\xdc\xd8\x9a\x25\xf9\x72\x59\x0a\xa9\x61\x2e\xc5\xd2\x84\xb3\x73\x56\x50\xcf\x10\x99\xe9\x6e\x66\x27\x18\xd4\xb7\xee\x7d\x36\xd3\x35\xb7\x2f\xea\x18\xf6\xa3\x2c\x4e\x63\x48\xdb\x0a\x91\x2b\xb5\x63\x47\x9f\xe9\x35\x70\x6d\xeb\x23\x79\xde\x70\xf3\xa0\xf9\x79\xcc\x5d\x4b\x96\x9f\xde\x81\xf9\xb3\x24\xf2\x9a\xf1\xd3\xbb\x16\x78\x47\x1e\x5a\x14\x80\x41\xff\x6a\x79\xfa\xc7\x1e\x58\xcf\xc2\xaf\x34\x91\xba\x6b\xb5\x7d\x54\xcd\xb4\x9f\x5e\x4b\xb1\x2a\x77\xd0\x6c\x59\x48\x17\xaf\x72\x85\x51\x27\x28\x6e\xd4\x66\xf8\xe9\x5b\x11\x1d\x33\x3c\x90\xd5\x53\x6b\xa1\xfb\x91\x3f\xda\xae\x5f\x8b\xb5\x67\xc6\x4b\x22\x6f\xde\xb1\x25\x4d\xe2\x96\x44\x71\x68\xd1\x7d\x2f\xcf\x36\x8f\xec\xee\x5d\xee\x7b\x70\x7c\x4d\x1d\x61\x2a\x97\x3c\xff\x0d\x19\xca\x25\xcf\x7f\x57\x66\x72\xc9\xf3\x7f\x09\x23\xf9\xf3\x7e\x23\x79\x85\x83\x9c\x9f\x1c\xa4\xbc\xbe\x69\x35\xf8\xad\x89\x0d\x36\x3d\x18\xfd\x74\xf9\xfa\x9a\x0e\xce\xf2\x1a\x5e\xdf\xed\xca\xf7\x2c\xb1\xd7\x42\xd3\x87\x02\x82\x86\x1a\x42\xbb\x98\xe0\xe9\x2f\xb7\xb7\x7f\x91\xe7\xd0\x0c\xba\x73\x9f\x3d\xb0\xc4\xe3\x94\xa2\xe8\xf5\x92\x72\xad\x76\x96\x70\x6a\x14\xfc\x4c\x16\x9f\x5d\x7c\x2b\x45\x29\x14\xcd\xc1\xa1\x4e\x46\x8b\xcf\x9a\xb1\xf1\x3d\x29\x9d\x0c\x0b\x5a\x94\xa7\xa8\x8f\xe8\xe2\x4a\x4b\xaa\xb3\x05\x35\x05\x2f\x25\x56\x3c\x87\x19\xd5\x6b\x4a\x39\x28\x56\x50\x9e\xd1\xba\x64\x46\x56\x39\x13\xe9\x64\x54\xb6\xc8\x36\xdb\xba\x1d\xdb\x84\x63\xdd\x6d\xfd\xa3\x57\xbb\xdd\xe8\x29\x56\xe7\xbc\x65\x6f\xd5\x4e\xaf\xac\xc4\x9d\x30\x27\x30\xa4\x30\xca\xf7\x3a\x0f\x88\x7b\x9e\x15\xac\xfc\x98\x88\xc7\xce\x78\x8a\xf8\x6f\xe6\xfe\x44\xb7\x74\xf8\x6c\x65\x63\xb9\x09\x81\x85\xa4\xf3\x69\x34\xc2\xe9\xa6\xf2\x73\x0c\xec\xa6\x9b\x8d\x25\x91\xbe\x60\x05\xdd\x6e\x3f\x25\xcb\x72\xac\xbd\x66\xb3\x29\xda\x4a\x40\x00\x39\x19\xb5\xec\xdd\x4d\xee\x66\x83\xf9\x06\x06\xd9\xd0\x22\x02\x84\xe7\x7d\xdd\x97\x3c\xdf\x6e\xd3\x9a\x58\x60\x07\x3d\x29\xd4\xca\xa6\x17\xa8\x3a\x4f\x25\xad\x62\x07\x0e\xdd\xad\xdc\xb0\x10\x1e\xdf\x3b\x15\x83\x1a\xe1\xb6\xc0\xb0\xd9\xa4\x26\x7b\xe8\x91\xdb\xd2\x08\x4d\x4d\x33\x7e\xef\xdb\x9b\x1d\x01\xc3\x5e\x71\x8a\x9a\x1f\x85\x03\x40\x2e\xd6\xbc\x10\x24\xbf\x78\x6e\xbf\x74\xc6\x99\x8c\xda\xdc\xf7\xe8\x66\xb4\x2a\x3a\x46\x92\xa1\x26\x7a\x5c\x42\x93\x86\x15\xac\x6c\x47\xc1\xf4\xce\xc4\xf9\x05\x2b\xff\x17\x43\xe0\x3f\xf5\xc0\x7a\x6e\xfa\x65\x4f\xe1\xb3\x8f\xa4\x17\x2d\xec\x20\xd8\x72\x1a\x87\x87\x35\xa8\xcb\xdf\x4b\xe4\x5b\xcb\xf2\x2f\x11\xce\x3c\x60\x1b\x6f\x56\xbf\x7e\x72\x84\x0a\xfd\x7d\x44\xbb\x56\x92\xdf\x83\x61\x7c\xca\x67\xaa\x1c\x9f\x1c\xa4\x36\x17\x90\xd5\x93\x69\x9c\x60\x5b\x8d\xf4\xae\x24\x3c\xa7\x79\xad\xc5\x0a\x0c\x10\x63\xa7\xbe\x8e\x91\xb6\xd5\xd4\x17\xed\x35\x6e\x7f\x6f\xe5\xcf\x9d\xae\x5d\x99\xe3\x20\x53\xff\x9b\x54\x47\x43\x17\x27\x70\x4b\x24\x68\x72\xfd\xda\x9d\xba\x28\x98\xc2\x66\x53\x1f\xc2\xa8\xed\x76\x5c\x03\xbd\x44\xeb\xb7\x3f\x6b\x08\xd3\x68\xb0\xb6\xe3\x93\x13\x18\x8d\x80\xf1\xb9\xf8\x4a\x88\x1b\x05\x44\x52\xc8\x48\x51\xd0\x1c\xd6\x4c\x2f\x4c\x29\x89\x72\x2d\xef\x81\x92\x6c\x01\x18\x22\x98\x36\x14\x0d\x98\x02\xdc\xfb\x68\x3e\x34\x54\x94\x00\xbd\x20\x1a\x74\xe7\x68\x95\x5c\x53\x98\x0b\x69\x7a\xf0\x30\xb5\x3e\x4b\x85\x8c\x70\x50\x0b\xb1\x06\xa6\xd3\x8a\xcd\x86\x97\x29\xbc\xff\x60\x19\x54\x65\xc1\x34\xa6\x03\xb0\x20\x78\x16\x6b\x98\xa9\x05\xca\x51\x1f\xa0\x71\x78\x0a\x95\x61\x88\x39\x30\xad\x9a\x93\x2f\x43\x05\xe3\x98\x6a\x4b\x54\x06\x54\x52\x85\xa3\xce\x57\xdc\xc4\x69\xcd\x28\x09\x9e\x34\x0d\x60\x53\xcd\x25\x72\x65\x4c\xc6\xe9\xac\x6e\x45\x02\x96\x4d\x6c\x02\x83\x07\x3f\xfe\x08\xef\x3f\x0c\xd2\xb9\x90\x97\x24\x5b\x24\x8e\x7c\xa2\x6b\x8a\x0e\x9f\xc1\x14\x74\xca\x78\x4e\xef\xde\xcc\x93\xf8\x3c\x1e\x8c\x43\x00\x8e\x43\x32\xb8\x80\x33\xf8\x1c\x74\xaa\x56\x33\xa5\x25\xe3\xd7\xc9\xd9\x10\xd8\x20\xd5\x92\x2d\x93\x41\xaa\xc5\x2b\xb1\xa6\xf2\x19\x51\x34\x19\xc0\x39\xc4\xb1\x47\x86\xcd\x21\xa9\xd5\x50\x4d\xfd\x7b\xae\x3e\x04\xbc\xe0\x27\xa9\x44\xc4\x3e\x98\x5a\x79\xcd\x0f\x2b\x4e\xb9\x52\x8b\xc4\x67\x81\xc1\x63\x78\xe2\x78\xf0\x19\xdf\x9a\xfa\x7d\x9b\x3e\xea\xca\x12\x09\x80\xab\xaf\x5b\xd7\xf6\x66\xf6\x3d\xcd\x74\x7a\x43\xef\x55\x9b\xed\x1e\x95\xf2\x66\x96\xf0\xdd\x23\x67\xaa\x68\x35\xa5\x1d\x89\xc6\xad\x91\x6d\xb0\x84\x7c\x8e\x4f\x60\x7b\xe2\x19\xc6\xf7\x82\x71\x43\xa4\x1e\xcd\xae\x2f\x9c\x1e\xb7\xcc\x52\xfc\x9d\xfc\x62\x72\x54\xd4\xbb\xa8\xb7\x01\x26\x7e\x10\xb2\xd2\x34\x57\xf0\x18\xe2\xf3\x18\x1e\xc3\xad\x63\x2c\x90\xb9\x2d\x3c\xa2\xb6\x85\x5f\x99\x73\x0b\x0c\x34\x93\x7a\xa8\xbb\x85\x84\x29\x70\xba\x86\x7f\x7c\xf3\xea\x2b\xad\xcb\xb7\xf4\x87\x15\x55\xba\x16\xff\x6e\x21\x53\x49\x55\x29\xb8\xa2\xe6\x54\x6a\x0a\xf1\xf7\x4a\x70\x67\x99\xd8\x2f\xb8\xa4\x24\xbf\x57\x98\xb2\x65\x0b\x73\xd6\x39\xad\x87\x6d\xc6\x72\x46\x8c\x28\x06\xe1\x0a\x11\x60\x3a\x9d\xb6\x06\x4f\x9f\xbf\x79\x7d\x19\xa0\xf9\xa8\x38\xcc\x4a\x19\xb4\xa7\x67\x67\x1d\x30\xfc\xe4\x22\x5b\x61\xe2\x98\x5e\x53\x7d\x59\x50\xfc\xfa\xe5\xfd\xcb\x3c\x89\xcd\x9d\x82\x78\x90\x1a\x1b\x82\x69\x20\x5d\x95\x60\x8c\x4f\x7c\x42\xfb\xa9\xa1\x3a\x77\x11\xc3\xbb\x08\x47\xd1\x6a\xae\x0c\xec\x24\xd9\x80\xf4\x50\xae\xad\xd7\xad\x93\xc6\x09\x06\x54\xd0\xfc\x07\x83\x1e\x02\xb5\xbf\xee\x9a\xe6\x7c\x00\x1b\x98\x07\x74\x06\xe3\xc6\xf8\xfc\x17\xae\x27\x7a\xa7\x25\x69\x73\x7f\x69\x1a\x7f\xfc\xb1\x71\xbc\xbd\x7a\xf9\x61\x45\xe5\xfd\x95\x39\x6c\x16\xf2\x8b\xa2\x48\xe2\xf7\xcd\xd1\xe0\x87\xb8\x67\xdd\xd0\xa2\xd7\x06\xf0\x43\x8b\x5a\x93\x86\xa7\xf7\xb4\x48\x91\x9a\xa2\x3a\x35\x75\x53\xe3\x42\x02\x2f\xeb\xde\x1d\xe1\xfa\x9d\x21\xbe\x49\x41\xa5\x4e\xe2\x67\x62\x55\xe4\xc0\x85\x06\x31\xd3\x84\x71\xc0\x34\x13\x5c\x04\x90\x06\x5b\x82\xe7\x30\x7d\xdf\xe9\x2d\xa9\x92\xf2\x24\xfe\xff\x97\xef\xe2\x21\xc4\x23\x9c\x9a\x9e\xb4\x38\x1e\x34\x08\x78\x40\x99\x0c\x70\xc5\x83\xb7\xe4\x9b\x63\x4b\xc7\x38\xf2\x62\x57\x7c\xe5\xd9\xea\x95\x8e\x3d\x35\x71\x98\x42\x14\x66\xc8\x3e\x94\x59\x29\x30\x3d\x70\x9d\xf9\x98\xb8\x2c\x60\x7a\xd8\x92\x0a\xf0\x1a\xdb\xdf\x8b\xde\x80\xf5\x51\x41\xdb\x87\xa9\xb7\x0b\xf8\x9d\x97\xd6\x6a\x6b\xfb\xfc\xf9\x6c\x12\x5d\x57\x6d\x8b\x9f\x4c\xa7\x10\xc7\x41\x7f\xc8\x43\x8f\x95\x4e\x6b\x53\x1e\x77\xad\xc6\x49\x91\x09\xae\x44\x41\xd3\x42\x5c\x27\x88\x3f\x18\x1f\xe1\xe3\x8d\xc1\x45\xdf\xbe\xb9\x7a\x17\x0d\x21\x1a\x55\x7b\x45\x5f\x29\x66\x08\x78\x33\xc3\x47\x55\x54\x5b\x8a\x5f\x51\x92\x53\x89\x4b\x81\x6b\xca\xf5\x29\x6e\x18\x68\xc0\xa4\x2c\x0b\x96\x99\xea\xfd\xc8\x6c\x1e\x21\x3a\xcf\x93\xaf\xaf\xde\xbc\x4e\xab\x90\x88\xcd\xef\x2b\xf6\x07\xbf\xe5\x2d\xc6\x57\x76\x54\xdd\x08\x80\xab\x55\x96\x51\xa5\xe6\xab\x22\x72\xbc\xf7\x51\x77\x2e\xf1\x1d\xbd\x0b\x23\x49\xff\x55\x39\x95\x0e\x78\x0f\xd9\xed\x89\xff\xcb\xbc\xfd\x9d\xfe\x48\x37\x16\xbd\xe4\x98\x12\x98\xb9\x02\x2b\xd7\x9c\xb0\x82\xe6\x9f\xfc\x93\x47\xf0\x18\x1e\x62\x69\x7b\xd2\xfe\xba\x35\x5e\xa9\x5e\x4d\x24\xcf\x2f\x6f\x6d\x2d\x19\x6f\x0c\x26\xf1\xf3\x37\xdf\x58\x8b\x79\x65\xb2\x90\x78\xd8\x33\xb5\x6e\x7f\xc3\x40\x4d\xdc\x50\x6e\x22\xaa\x64\xa7\x27\xc0\x00\x28\xae\x4d\x28\x09\xf3\xac\x5d\x81\x7d\x2b\x7a\x33\x7b\x59\x01\xd3\x1d\x9e\x20\x89\xd3\xe0\x16\xdd\xfb\xf6\x25\x3a\x0c\xd7\xb8\x32\x77\x59\x30\x7c\x8b\x3e\x04\x7b\x40\x37\x36\x34\x90\x1f\x42\x01\x69\x31\xf4\x59\x0a\x10\xcf\x1d\xf5\x61\x08\x90\x89\x42\x48\xd3\xf9\x0c\xbf\xb5\x7a\x15\xe3\xd7\x05\x35\xdd\x57\xe6\x6b\xd3\xbd\x75\xfc\xd5\x5f\x5a\x96\x84\xed\x93\x91\xcb\x60\xf7\xdf\x76\xd9\x99\xf3\x36\x87\x2a\xef\xc2\xc4\xb6\xe9\x68\x72\x46\x37\x37\xa0\x28\xbd\xf1\x32\x2f\x84\x37\xd5\xdc\x7d\xdb\x41\x55\x50\xae\xb5\x6e\xe0\xd3\x6c\x25\x25\xe5\x1a\x0b\x2d\xa8\xea\xa0\x0f\x11\x92\x41\x3b\x70\xae\xeb\x32\x2c\xaf\xc7\xdf\x35\x26\xcb\xed\xbe\x83\xfe\xc9\x2c\x24\x83\xf9\x20\x8b\x3e\x5b\x8e\x81\xd1\x08\x24\xe5\x39\x95\xcd\x7d\x08\x05\x05\x53\xba\xca\x7a\x7d\x75\x61\x46\x9c\x4b\xb2\x36\x3d\x4c\x1a\x96\xcd\xed\xa1\xd1\x08\xc4\x2d\xad\x32\xf6\x82\xf2\x6b\xbd\x70\xc9\xbc\xa4\x99\x90\x39\xe3\xd7\x7e\xe6\xdc\x19\x2f\xc1\xf1\x6a\xa9\xc3\x19\xc2\x2e\xa7\x3e\x93\x46\xcd\xf6\x47\x05\x1e\x76\x33\x27\x44\xc2\x8c\xc8\xc3\xd0\xbe\x24\xde\x64\x12\x09\xf9\x4a\x92\x87\x42\x82\x5a\xc1\x0e\xd8\xe2\x9b\xb3\xad\x94\x71\x4e\xe5\x57\xef\xbe\x79\x05\xd3\x26\x0e\x9c\x11\xd9\xdf\x81\x02\x77\xbd\x06\xa9\xd5\xe3\xf8\x92\x62\x0d\xd3\x7a\x04\x45\xa5\x7e\x2b\xd6\x81\x2b\x46\xa8\xf5\x82\x06\x9c\x67\x92\x12\x4d\x2d\xf3\x49\x4c\x6a\x51\xf1\x83\xc0\x29\x96\x3a\xad\xa7\x0c\xcd\x8b\xa4\xa6\x90\x3c\x40\x37\xf3\xdf\xff\xf9\x5f\xe8\x78\x82\xde\x4b\x9e\x77\x88\x09\x9e\x61\xd9\xb1\xb5\x8f\x56\xeb\xcc\xd1\x1b\x83\x1f\xac\x4b\xb1\xb6\xe2\x3c\xa3\x45\x91\x0c\x52\x52\x96\x94\xe7\xcf\x16\xac\xc8\x13\xa4\x39\xd8\x07\x1c\x32\x4f\x52\x73\x80\xed\x21\x78\x99\x78\x1b\xd7\x83\x4a\x6c\x14\xb7\xcb\x85\x37\xb6\xea\x5e\x48\x37\x5b\xb0\x72\x8f\xaa\x55\x49\x9a\x98\xc4\xbd\x10\x27\x35\x95\x47\x74\xb1\x68\x06\xa6\x82\xd8\x54\x71\xe3\x3e\x84\x50\x4a\xdd\x02\x41\xf9\x02\xad\x21\xce\xe0\x21\xa0\x16\xdb\x18\x08\xbc\x16\x39\x4d\x62\x68\x76\xb8\xc0\x6b\x1f\xa4\x7f\x3c\x46\xef\xa4\x3f\xa8\xac\x9c\x16\x7b\x74\x55\x95\xb6\x03\x6d\xe5\xb4\x08\x15\x65\x0b\xb5\xe6\x10\xce\x04\x32\xfe\x10\x08\x1d\xf2\x12\x3f\xa7\x05\xd5\xb4\x0d\xb4\xc3\x40\x73\x03\xdc\xb8\xa8\x84\xa4\x2f\x9f\x1f\x63\xa9\x39\x2d\x06\xe3\x93\x06\x18\xe3\xb2\xda\x93\x5c\x40\x37\xde\x43\xa5\x54\x0e\xf5\x58\x1b\xaa\xb0\x42\xe5\x54\x6d\x71\x3f\xa4\xb6\x99\xd5\x8e\xb5\x0d\xb8\xb2\xbb\x4b\xc7\x23\x60\x6e\x15\xa4\x05\x9d\xa3\x62\x93\x27\x67\x67\xf0\x08\x2c\x05\x18\xd5\x0e\xd3\x10\xfb\x7f\x3b\x78\xa8\x48\xac\x59\xae\x17\x0d\x8d\xca\x89\xc0\xa9\x23\x36\x38\x94\xda\xf1\x6e\xc6\xb9\x60\x7f\xce\x2a\xd6\x06\xe3\x6e\x70\xd9\xd9\xad\x9b\xed\xc2\xc6\xfa\xc9\x4a\x16\x43\x98\x89\xfc\xbe\x9e\x59\x9c\xd1\xc3\xd3\xa2\x18\xd3\xa2\x78\x08\x86\x4e\x3b\xfb\xf9\xcd\x65\x26\xdd\x2d\xdc\x24\x57\x25\x91\x8a\x76\x13\x0a\x27\xca\xa1\xf9\x41\xdc\x10\xb6\x05\xc5\x26\x3d\x88\x3f\x36\x3d\x70\x12\x06\x73\xf4\x33\xa4\x97\xfb\x52\x4c\x33\x96\x03\xec\x4a\x5d\xa3\xd5\x20\x6d\x33\x0b\xaf\xa9\x3a\x5c\xb4\x2c\x85\x0b\x04\xa6\x78\x76\xa2\xe8\xfe\xf0\xaf\x73\xfb\xb1\x0a\x1e\xdd\xa0\x48\x8d\xf2\x1c\x15\xb9\x2f\xc6\x69\xa8\x98\x43\xc3\xa0\x52\x60\x49\xc0\xd4\x11\xb2\x85\x7e\x63\x43\x71\x0c\x9f\x5b\x76\xcf\x3d\x76\x2d\xa4\xe3\x02\xa7\x86\xa9\xd7\xe4\x75\x62\x40\x07\xb8\x6b\x54\xbf\x29\xcf\x07\xc1\x84\x59\x23\x41\x2a\xd5\x19\xd4\x5a\x32\xad\x29\xc7\xab\xe0\xd5\xc6\xa9\x86\xb0\x3c\x57\x0a\x84\x84\xc5\xf9\x12\xbf\x86\xa5\xb1\xaa\x80\x3d\x0e\x6c\xa3\x91\xcf\x5a\x42\x12\x8f\x9a\x36\x55\x95\x29\xd0\xfa\x28\xc7\x7b\x2f\xdf\xbd\x7d\xf9\x4c\x2c\x4b\xc1\x8d\x63\x0e\xca\x17\xf1\x20\x48\xa7\x8c\xd2\xcf\x2b\x0d\x78\x69\x12\x5e\xe0\x44\x75\x79\x4d\xc6\xe5\x9e\x1f\x32\x05\x06\xd2\x4d\x82\x47\x01\xc3\x96\xf3\x9e\xe4\xc7\x1e\x37\x78\x90\xb8\x33\x1f\x34\x14\x02\xba\x91\xac\xc2\x9c\x2a\x0f\xc0\xc6\xac\x2e\x1e\xa0\x3b\xa0\x8d\xd3\xeb\xe1\xcf\x15\x94\xdf\x7f\xe8\xf8\xda\xce\x5e\xec\x65\x48\x0f\x4c\xda\xa8\xc2\x3d\x6a\xee\x70\x9f\xf9\x94\xe5\x06\x9c\xe5\x1d\x6e\x2a\xcf\x57\xdf\x8d\x0f\x32\x17\x5c\x06\x22\x88\x6b\xda\x8a\x71\xd7\xf8\x6b\x6b\x14\xc5\x31\x59\x40\x56\x0f\xe5\x86\x2b\xd8\x9e\x70\xa1\x60\x81\xd5\x23\x3c\x39\x38\x19\x20\xad\x00\xca\x8b\x16\xb2\x76\xb4\x90\x75\xce\x35\xc8\xde\x3d\x39\xeb\xdd\x93\x0b\x16\x6c\xc7\x75\x6d\xd1\xea\xc9\xef\x2b\x58\xa7\x8a\xf0\x90\xce\xf1\x5a\xa1\xb3\x63\x9b\x57\xa6\x4b\x52\xee\x52\xae\x3d\xe3\x3a\x5c\xea\xed\x20\xc5\x9a\x6f\x12\xff\x93\xc7\x1d\xab\xb1\x3c\xfc\x5f\x0e\x16\xea\x25\xf3\xb3\x46\x0a\x96\xea\xcf\x1b\x26\x1c\x1f\x11\xdc\x9d\xae\xd7\xeb\x53\x34\x96\xd3\x95\xc4\x7b\xaf\x02\x4b\x8c\x6e\xc0\x7a\x9f\x37\xf1\x40\xdb\x36\xc2\x27\xa1\x9c\xac\x2d\x8b\x89\x47\xb6\xe1\xc8\x8d\x28\x94\xd8\xbe\x6a\x37\xb4\x8b\xcc\x31\x6b\x69\xd0\x31\xf6\xf6\xe3\x43\x0f\x8a\x34\xaa\x30\x8e\x93\x0c\xcf\x37\xec\xc8\xa3\x11\x34\x57\x19\xe1\x87\x15\x5d\x51\x53\xa3\xb2\xad\xae\x18\x85\x77\x77\xea\xeb\xc9\xd8\xc0\xb8\xb9\xad\x2a\x56\xda\xd4\xc1\x4a\xc1\xb8\xae\xca\x5c\xe8\x7e\x15\x30\x0d\x82\x67\x14\xff\x2e\x88\x82\x19\xde\x7c\xcd\x56\xc1\x2d\x10\xff\x0a\xe5\x47\x86\x67\xde\x15\xbd\xde\xc0\xec\x70\x2a\x5e\x60\xf6\x9b\x09\xab\x50\x08\x9b\xaa\x4f\x61\x3f\xf7\x46\x95\x35\xc1\x8f\xf3\x88\xf1\x08\x15\x71\x6c\xb4\xf6\xf3\xba\xd0\x4f\x0e\x74\xa1\x81\xba\x3c\x95\xf5\xb8\xd7\x4f\x8c\x7b\x7d\xda\x21\x61\xe7\xac\xba\x9c\xc6\xf8\x75\x63\xe7\x47\x79\xc2\x7d\x9c\xd8\xab\x4b\x30\x85\x7d\x8e\xdb\xc3\xad\x66\xbb\xde\x68\x1b\xe6\xd2\x34\x8d\xbb\x70\x39\x53\x58\xc3\xcc\xb1\x98\x29\x83\xb3\xc7\x35\x61\xfa\x85\x90\x5f\x8b\x59\x22\xa9\x4a\xbf\x16\xb3\xbe\x33\x9b\x16\xb9\xd6\xb0\x46\x19\xf1\xb8\x17\xd4\x1b\xb9\x7a\xec\x34\x84\x3a\x36\xbc\x6a\x70\xf8\xcd\xc1\x51\x16\xbe\x11\x21\xc5\xcb\xe3\xa8\xad\xe0\x82\xfa\x0e\x03\x46\x6d\xa0\x01\xf7\x12\x0a\xa3\x36\x07\xda\x82\x44\x3e\x73\x7b\x09\xfd\x28\x5e\x1d\xd2\xee\x5a\xdc\xce\xd2\x65\x8d\x5a\x8b\xea\xdd\x94\x3f\x52\xd2\x9a\x96\xfb\xb2\x5b\xd4\x1a\x34\x54\x4c\xec\xee\xe0\xb7\xd9\x6c\x45\xa1\xa8\xd3\xc1\x7e\x90\x43\x4b\xa7\xbd\xa8\x15\x13\x6d\xb0\xbd\x8e\x52\xc5\x83\x1d\xc1\x70\x10\x10\xff\xd2\xc1\x4c\x6c\x76\x14\xe3\x64\xcd\x37\x93\x38\x51\x9e\x5b\xb7\x5b\xa7\x4e\xa3\x11\xa0\x88\x7f\xb7\x0f\x2e\x35\x47\x48\xf5\xb3\x4c\x6e\x83\x36\x8f\x08\xc1\x0a\xa3\x45\xff\xbf\xec\x10\x9e\x9b\xfd\xb9\x39\x95\x72\x4f\x29\x41\xe9\x9e\x5b\x32\x8f\xbf\xe0\x25\x4e\xf7\xd8\xd1\xd0\xb8\x0f\x74\x89\xee\x2e\xe9\xf7\x62\x66\xc8\xac\x85\xbc\xb1\xae\x72\x09\x62\xa5\xd1\x85\x73\x4a\x73\x9a\xfb\x1b\xbb\xcf\x72\xf2\xb1\x61\xba\xbb\x5b\xe3\x04\xfd\xbd\x6d\x4b\xd3\x1d\xdb\x92\xe7\xb9\xf7\x6d\x1a\x95\x4b\xf7\x35\xed\xa4\xf5\x62\xff\x03\x13\x0d\x9c\x99\xf5\xfc\xf0\x5d\x0a\xdf\x8a\xea\x55\x59\x4f\xf2\x7a\xde\xee\xaf\x12\x97\xfa\x01\xb1\xf5\x3c\x75\xdf\x07\x5d\xf5\x6c\x5b\xcb\xc3\x99\x7f\x6d\x51\x9d\xd1\x7e\xd2\x31\x33\x1e\x38\x99\x7f\xc3\xb4\x0f\xc9\xd9\x5d\x80\xe6\x2a\xea\x3b\x6d\xc7\xa6\xbe\x4c\xbd\x60\x9c\x69\x9a\x18\xde\xd2\xa6\x10\xff\x39\x84\x2d\x70\x0e\xeb\x79\xfa\x2d\x3e\x10\x9a\xda\xe3\xdf\x11\xb6\xbc\x6d\xae\x27\xba\x34\x1f\x45\xc5\xf5\xbf\x73\x6c\x04\xc8\xf4\x1d\x4c\xad\x74\xa8\x07\xe3\xb4\xef\x74\x12\x3f\x6d\xbc\x90\x83\x5d\x37\x90\xe6\x30\x61\x08\x8b\xa6\xa5\xfa\x67\x56\x2d\x8c\x92\xca\x6f\xd9\x9d\x39\x7e\xea\xe1\xda\x03\xce\xf4\x5d\x9a\x15\x94\xc8\xb7\x34\xd3\xc9\xd9\x10\xce\x86\xb0\x1e\xc2\xc2\x67\x01\x61\xe6\xac\x28\xae\xf0\x38\x03\xf7\xb4\x3f\xfc\x95\xe0\xdb\xdf\x54\xd0\xff\x24\xc8\x2b\x4a\x75\x36\x86\x3b\x98\xc0\x7a\x0c\x77\x8f\x1f\x07\x92\x3b\xfe\x8c\x27\x9b\xc2\x37\x44\x2f\xd2\x79\x21\x84\x4c\xee\xe0\x51\xcd\xf5\x60\xdc\xc5\xd0\x68\x38\x06\x7e\x49\xee\x12\x83\xff\x18\x9e\x0c\x7d\x12\xc9\x9d\xb9\x7f\xed\x13\xea\xa3\x84\xff\xc9\xc2\x30\x19\x76\xd5\x12\x60\xb1\x09\xe9\x8f\x81\xc1\x04\xb4\x18\x03\xeb\x91\x02\x3f\x96\x52\xcd\x15\xfe\x1e\xd6\x66\xf2\x9e\x99\x4b\x91\x67\x6d\x1e\xb6\x27\xfe\x2f\xef\xdc\xbe\xa6\xf3\x04\x27\x18\xa5\x20\x37\x30\x82\x27\x67\x1d\x12\x6e\x46\xcc\xa4\xdd\x0d\x21\x59\xc0\x29\x12\xc1\xb3\xa4\xa7\x43\x54\x0b\xfe\x18\x9f\xf4\x8d\x89\xe3\x11\x0c\x0e\xd6\xf0\xa8\xe7\x22\x47\x73\x18\x95\xec\xb7\x81\x27\x7f\xf9\xcb\xdf\x66\x24\xee\x81\x31\x5c\x79\xf3\x42\xf4\xc0\xd8\xd5\x53\xcf\xae\xdc\x5a\xb1\x46\x5c\x9d\x94\xd9\x27\x78\xfd\x12\xa1\xed\x77\x67\x68\xf6\x67\x56\x30\xca\xf5\xdf\xb1\x31\x84\xeb\x5e\x89\x32\x67\x66\xfe\x3d\x28\x1a\x4c\x25\x6a\x43\x36\x84\xaf\xa9\xfe\x12\x1f\xf0\x65\xfc\xfa\x99\x19\xe3\xad\x7f\xb1\x13\x8f\xcd\x4d\x69\xaf\x9e\xa9\x33\x6b\x80\x4b\xc6\x93\x27\x43\x48\xa8\x65\xed\x1f\x70\x0a\xd2\x1c\x1e\xe2\x94\xc8\x8a\xff\xc1\x00\x1e\x79\xda\xad\x55\xe1\xbe\x54\x93\xd1\x15\x01\xef\x01\x55\xa5\x9f\x78\x68\x02\x0b\x87\xb0\x66\x3c\x17\xeb\x1e\x0c\x49\x15\xfb\x0f\xda\x7b\xfb\xeb\x18\xa5\xe2\x1b\xc7\x4b\xba\x75\x48\xd7\x1a\x38\xff\xd6\x56\xd2\x29\x1b\x3f\x78\xaf\xc5\x7b\x38\x3a\x1e\x3c\x7c\xbd\xa4\xbf\x7e\xac\x7e\xfd\x5b\x24\x6a\xef\x2d\x12\x75\xf4\x2d\x12\xd5\x5b\x4a\x3e\xea\x16\x09\x4a\x48\xf2\xfc\xb8\xfb\x08\x24\x3f\x36\x07\x22\x79\x27\x07\xb1\x87\x1a\xc1\xad\x04\x04\xeb\x17\xb8\x81\x39\xe0\xe8\xe3\xc8\x50\xb3\x45\xdc\x3f\xb7\xaa\x14\x3c\x3c\x69\xf7\x57\x27\x58\x66\xc6\x7a\x3a\xed\x59\x56\x7c\x55\x52\x9a\x2d\xe2\x1e\x88\xea\xac\xea\xfd\x87\x9e\x2e\x3c\x73\xc2\x27\x93\xc2\x9e\x7a\x89\xf9\x5e\xf2\xc1\xd9\x26\x79\xde\x5d\xa3\x0f\xac\x32\xcc\xb0\xda\x7e\xd7\xac\x28\x1b\x28\x54\x8f\x58\xc5\x31\x3e\x3e\x85\xff\x52\x21\xb6\xcb\xdd\x3c\x36\x16\xfe\x5b\x01\x3c\xc3\xc5\x9a\xa2\x37\x5f\x78\xa9\xaa\x7a\x42\xae\x4e\x5f\xec\xb8\x7e\x02\xd2\x26\xd4\x58\x40\xdf\xcc\xbb\x48\xcf\x99\xc0\x71\x67\x5e\xce\x5d\x35\x8f\x67\x98\x27\x82\x6a\xe3\xc3\xf6\x7a\xf8\xee\xd9\x3f\x76\xa7\x5e\x43\xf8\xcc\x54\xab\xfc\x6f\x80\xdd\xaf\x06\x12\x27\xf7\xa7\x5f\xe0\xc5\xa5\x6c\xb3\xc6\xe9\xee\x59\x6e\xc5\xd2\xf6\x11\x7a\xa7\xc2\xf6\x63\xf4\x61\x26\x38\x3e\xe9\x79\x3e\xd3\x8e\xd9\xc3\x35\x22\xd3\xdc\x3d\xa0\xb1\x73\xdb\xe9\xea\xb4\x99\xe4\x20\xd9\x40\x01\xeb\xba\xf2\x8b\x42\x10\x9d\x60\x26\xfa\xdd\xdb\x57\x57\x94\xc8\x6c\xf1\x2d\x91\x64\xa9\x92\x42\x54\xb9\x7c\xaa\x4c\xeb\x00\x55\x90\xc4\x3a\xac\x47\x60\x66\xa5\xe1\x62\xda\x73\x1b\xc9\xca\xd3\x7f\x8d\xd5\x8b\x9a\xea\x15\xd5\x3d\xd2\x3d\xf0\x0e\x75\x80\x68\x6f\x53\xef\xba\x01\xfc\x3f\x03\x00\x7d\xd2\x2b\xf4\x8c\x57\x00\x00")
+
+func staticTmplEntryTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplEntryTmpl,
+		"static/tmpl/entry.tmpl",
+	)
+}
+
+func staticTmplEntryTmpl() (*asset, error) {
+	bytes, err := staticTmplEntryTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/entry.tmpl", size: 22412, mode: os.FileMode(420), modTime: time.Unix(1792400509, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplFieldsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\xcb\x6e\xe3\x38\x10\xbc\xfb\x2b\x0a\x0c\x36\x27\x3f\x12\x60\x17\x0b\xc4\x92\x2f\xd9\xec\x69\x6e\xf3\x01\x03\x9a\x6c\x4b\x04\x28\x52\x68\xb6\xe4\x31\x04\xff\xfb\x40\x12\xfd\x48\x26\x19\x80\x07\xbb\x58\x5d\xea\x2e\x16\x39\x0c\xb0\x74\x70\x81\xa0\x4c\x0c\x42\x41\x14\xce\xe7\x45\xb1\x67\x6c\x76\x8b\xc2\xba\x1e\xc6\xeb\x94\x4a\x65\x34\x5b\x85\x24\x27\x4f\xa5\x3a\x3a\x2b\xf5\x0b\xfe\xfd\xe7\xaf\x2d\x1a\xcd\x95\x0b\x2f\xd0\x9d\xc4\xad\xda\x2d\x00\xe0\x63\xe1\xca\xba\xde\x59\xe2\xbc\x3d\xae\xd7\x2e\x49\x6c\x70\x70\xe4\x6d\x9a\xd0\x62\x63\x5d\xff\x45\x7d\x22\x23\x2e\x86\xbb\xfa\xa2\xbd\xfd\xfe\x4d\x0f\xda\x5a\x34\x24\xda\x6a\xd1\x90\x08\xea\x89\x4f\x18\x7b\x88\x6b\xe0\xbf\x79\xe4\x18\x68\xe6\xa3\x25\x86\x1f\x21\x9d\xde\x89\x16\x26\x5a\xda\x05\xdd\x10\xe4\xd4\x52\xb1\x99\xfe\x2f\x71\xac\x89\x09\x52\xcf\x30\x5c\x9a\xb4\xe2\x21\x17\x24\x61\x17\xaa\x0b\xfb\x33\xc5\xae\xd9\x13\x5f\xe5\xe6\x2a\xab\xe5\xf6\x85\x19\xda\xc7\xe8\x49\x87\x0f\x68\xc7\x3e\x23\xef\xa4\x23\xe7\x7d\x0a\x5d\x93\x09\x6b\xe0\x2d\x74\x57\x5b\xbc\x4b\x32\xb6\xed\x18\xa6\x8e\xce\x50\xfa\x7c\x62\xef\x0c\x85\x44\x18\x95\x50\xc2\x98\xd5\xfe\xb4\x84\x31\x4f\x37\xd9\x6f\x2e\x50\x42\x12\xcd\xe2\x42\x85\xa3\x93\x3a\x17\x3f\x7c\xd6\x9c\x66\x82\xab\x42\x64\xb2\x6b\xe0\xff\x7c\x4a\x4c\x48\xa4\xd9\xd4\x64\xb1\x3f\x61\x34\x7a\x89\xd4\x99\x1a\x3a\x65\x35\xd6\xa3\xfe\x63\x25\xdb\xf2\xef\x3f\x4e\xdd\xb8\x94\x5c\xa8\x5e\x72\xef\x99\xbb\xbe\x92\x8b\xcd\x5d\x62\x86\x61\x05\x77\xc0\xfa\x8d\x39\xf2\xf9\x7c\x23\xbd\x0b\x9e\xf7\xb1\x13\x68\x4f\x2c\x6a\x37\x0c\x17\xf6\x5d\x50\x2f\x5a\x14\xec\xbd\xca\x21\x72\x33\xe6\xaf\x8e\xb6\x54\x6d\x4c\xa2\xa0\xa7\x00\x97\x6a\x33\x1f\xc5\x5d\x92\xc7\x55\x08\xfd\x14\xcd\xa4\x27\x0f\x4a\x95\x49\xe0\x78\x4c\xa5\x7a\x7e\x52\x68\xbd\x36\x54\x47\x6f\x89\x4b\x15\x5b\x62\x2d\x91\x31\x27\xed\xf1\xe1\xf9\x69\x9b\xea\x18\xe5\x87\xb3\xf7\xd8\x6c\x1e\xe6\xb8\x4d\xac\x2f\x4f\x76\x09\xed\xfd\x8a\x5d\x55\x4b\x5a\x31\x25\xe2\x9e\xec\x34\xf5\x6b\x0c\x07\x57\x8d\x63\x5f\x9a\xfc\xd0\xbb\x0b\x6d\x27\xd3\x4d\x28\x55\xea\xf6\x8d\x13\x75\xf1\x70\xdf\x89\xc4\xa0\xd0\x6b\xdf\x51\xa9\xbe\xeb\x3e\x5f\xb9\xa4\xc6\x07\xe6\x2a\xb1\x19\x1d\xcb\x77\x7f\x76\x37\x9b\x9c\x5f\xa2\x61\x00\x05\x8b\xf3\x79\xf1\x6b\x00\x97\x48\xa6\x81\xb2\x04\x00\x00")
+
+func staticTmplFieldsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplFieldsTmpl,
+		"static/tmpl/fields.tmpl",
+	)
+}
+
+func staticTmplFieldsTmpl() (*asset, error) {
+	bytes, err := staticTmplFieldsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/fields.tmpl", size: 1202, mode: os.FileMode(420), modTime: time.Unix(1792398577, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplImageTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x51\xcd\x6a\xc3\x30\x0c\xbe\xe7\x29\x3e\x04\x3b\xa6\x39\x8d\x41\xeb\xe4\xb8\x17\xd8\x5e\xc0\xb3\x95\x56\xe0\x38\xc5\xd6\xb2\x0d\xe3\x77\x1f\x69\x3b\x4a\xcb\x36\xf0\xc1\xb6\xf4\xe9\xfb\x51\x29\xf0\x3c\x4a\x64\x90\x9b\xa3\x72\x54\x42\xad\x8d\x79\x4b\xe8\x86\xc6\x78\x59\xe0\x82\xcd\xb9\x27\x67\x93\x27\x64\xfd\x0a\xdc\xd3\x87\x78\x3d\x6c\xf1\xf4\xf8\xb0\xc3\x64\xd3\x5e\xe2\x16\xf6\x5d\xe7\x1d\x0d\x0d\x00\xdc\x03\x5b\x2f\x8b\x78\x4e\x97\xf2\x7a\x4a\x91\x11\x9b\x57\xd1\xc0\xb5\x96\x72\xbd\x71\xc8\xe7\x9f\x67\x09\x1c\xed\x74\x7a\x70\xf4\xb5\x9e\xb0\xa6\xf3\xb2\xfc\xc1\x92\xd9\xa9\xcc\x11\xca\x9f\xda\x3a\x8e\x7a\xc3\x68\x2c\x0e\x89\xc7\x9e\xba\x55\xcb\xdc\x8e\x12\xb8\xbb\xe1\xa1\xc1\xc8\xb4\xff\x19\x99\x55\x42\x20\xe4\xe4\xfe\x83\xc0\x06\xed\xe9\x6a\x80\xd0\x0d\xa6\xb3\xc3\xbd\xd8\x52\x5a\x28\x4f\xc7\x60\x95\x41\x13\xab\xf5\x56\x2d\x61\xb3\xc6\x7d\xf6\x74\x49\xbd\x29\xe5\x97\xce\x17\x97\xe4\xa8\x84\xcd\xba\x9f\x52\xc0\xd1\xa3\xd6\xe6\x7b\x00\x5a\x6d\x77\x7a\xc1\x01\x00\x00")
+
+func staticTmplImageTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplImageTmpl,
+		"static/tmpl/image.tmpl",
+	)
+}
+
+func staticTmplImageTmpl() (*asset, error) {
+	bytes, err := staticTmplImageTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/image.tmpl", size: 449, mode: os.FileMode(420), modTime: time.Unix(1792400509, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplJobsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x51\x6f\xdb\x36\x10\x7e\xf7\xaf\x38\x70\x19\x90\x00\x89\xe4\x16\xcb\x06\x24\xb4\x86\xae\x5b\x81\x75\x45\x81\xad\x05\xf6\x4c\x8b\x67\x89\x33\x45\x6a\xe4\xc9\xa9\x40\xf0\xbf\x0f\x94\x2d\xc7\x76\x6c\x27\x5b\x5f\x06\x19\xb0\x74\x3c\x7e\x77\xf7\x1d\xef\x8e\x21\x80\xc4\x85\x32\x08\xac\xb4\x86\xd0\x10\x83\x18\x27\x7c\xee\x20\x2f\x26\x5c\xaa\x15\x94\x5a\x78\x3f\x63\xa5\x70\x92\x81\xa7\x5e\xe3\x8c\x3d\x28\x49\xf5\x1d\xfc\x70\xfb\xed\x3d\x34\xc2\x55\xca\xdc\x81\xe8\xc8\xde\xb3\x62\x02\x00\x70\xb8\xf1\x46\xaa\x95\x92\xe8\x36\xcb\xe9\xf7\x93\x28\x97\x95\xb3\x9d\x91\xf0\x97\x9d\xfb\x41\xce\x73\xa9\x56\x27\x10\x3c\x96\xa4\xac\xd9\x41\xe0\xed\xe3\x7b\x7a\x3e\x69\xfb\x00\x0d\x4a\x25\xe0\xc1\xba\x25\xf8\xae\xac\x41\x78\x90\x48\x69\xaf\xa9\x60\xa1\x34\x02\xf5\x2d\x7a\x10\x46\x42\x23\x96\xca\x54\x40\x75\xd7\xcc\x8d\x50\x7a\x90\xee\x41\x7a\xb2\xae\x9f\x5b\xe1\xa4\x07\xd7\x19\x0f\x35\x3a\xbc\x86\x10\xb2\x3f\xad\x5b\xa2\xf3\x31\x82\x20\x10\x40\xaa\xc1\x0c\xe0\x9d\x50\x1a\xd7\x01\x81\x70\x08\xe4\x14\x4a\x10\x95\x50\x66\x0f\x57\x0b\x42\x77\x0d\x0f\x42\x0d\x7e\x69\x6b\x2a\x74\x20\x16\x84\x0e\x50\x94\x35\x2c\x84\xd2\x5d\x32\x95\xfc\xb4\xa6\x44\xa0\x1a\xfb\x41\x0c\x0b\xeb\xa0\xb2\x56\x0e\xa2\x3d\xd8\x64\xd2\x1a\xdd\xef\xda\x85\x87\x1a\x4d\x52\x5d\x47\x5f\xd6\xc2\x54\xe8\xc1\xba\x24\xeb\x07\x2f\x1d\xae\xf5\x53\x70\xd9\x16\x90\xe7\x3b\xfc\x86\x70\x03\x6a\x01\xd9\x7b\x3b\xf7\x31\x3e\xea\x90\x98\x6b\xdc\x4f\x03\xa7\x1a\x85\xdc\x97\xa5\x87\x93\x7b\x2a\xdc\x6c\x28\xde\xdb\x39\xcf\xa9\x3e\xad\xf0\x4e\x69\x3c\xaf\xf1\x89\x04\x75\xfe\xbc\xce\xef\x1d\x76\x28\xcf\xeb\x1c\x5f\xe5\xf9\xa1\xfb\x3c\x3f\x12\x28\xa7\xb9\x95\xfd\xbe\x6c\xe4\xcf\x25\xe6\x9f\x50\xf8\x02\x7a\x64\xf1\x4d\x08\xd9\xaf\x3f\xc7\x98\x4e\xde\x6f\xca\xc8\x18\x43\x58\xc3\x5d\x2c\xaf\xe1\x62\x05\x77\x33\xc8\xde\xb8\x2a\x9d\x47\xee\x5b\x61\xc6\xd2\xd1\x62\x8e\x1a\x3c\x96\xd6\x48\xe1\x7a\x56\x84\x70\xb1\x8c\x71\x16\xc2\xc5\x2a\x46\x9e\x27\xdd\x22\x04\x4c\x90\x3c\x27\x79\xda\x05\x2e\xa0\x76\xb8\x98\xb1\xbc\xd5\xa2\x47\xf7\x63\x3a\x4e\xb3\x10\xb2\x94\x99\x18\x59\xb1\x7d\xe5\xb9\x28\xce\x63\x1d\x5d\x48\xbf\x23\xbe\x87\xa0\x16\x80\x7f\x43\xb6\xce\x2f\x30\x69\x0d\xb2\x18\x7d\x57\x96\xe8\x7d\x08\xa8\x3d\xc2\x81\x4e\xaa\x13\x94\x2c\x46\xa1\xd1\xd1\x71\x1d\xd7\x19\xa3\x4c\xc5\x62\x6c\x9d\x6a\x84\xeb\xd7\x6a\x31\x6e\xd9\xda\xf0\x32\x84\xb6\xde\xb5\xa5\xec\x64\x04\x9b\x42\xa9\x08\xb2\x37\x44\xd8\xb4\xe4\xe1\xd5\xd0\x25\x86\x8f\x94\xc0\x51\x9e\x92\x38\x18\x78\x0e\x2c\xf5\x80\xcb\x5d\xd7\x5b\x34\x32\xb9\x7e\x05\xd9\x2f\xce\x59\x17\xe3\x35\x18\xfc\x42\x40\xae\x4f\x16\x3e\xe2\x17\xfa\xec\xfa\xec\x9d\x75\x8d\x20\x60\xaf\x6e\xef\xa6\xdf\xdd\x4d\x6f\xd9\x8b\x4d\x8e\xb8\xeb\x41\xc0\x7d\x23\xb4\x4e\x34\x8c\xe2\x7c\x94\xbc\x0c\xed\x83\xad\xce\x68\x71\x89\x94\xda\xef\xe9\x73\x91\x1e\xee\xbb\x26\xa5\xa9\xf8\x60\x2b\x9e\x8f\x1f\xe7\xb7\xb4\x0e\x8b\xb1\x52\xd6\x4e\x84\x90\xc5\x38\xd9\xf8\xcd\xf3\xa4\x70\x12\x82\xe7\xcf\x3a\x96\x02\x3c\x4d\xc1\xf9\x2a\x08\x21\x7b\xeb\x50\x10\xca\x6d\xa2\x5e\x4f\xa7\xdf\xdf\x4c\x5f\xdd\x4c\x5f\xc3\x4e\xce\xfe\x63\x31\x6d\xc8\xb7\xee\xd4\xe1\xb9\x3c\x56\x0e\x57\xe7\x12\xb5\xb0\xae\x81\x06\xa9\xb6\x72\xc6\x5a\xeb\x89\x81\x18\xc6\xf2\x8c\xe5\x69\xe8\xe5\xa5\x30\x25\xea\x9d\x21\x7d\xec\xe1\xca\xb4\x1d\x0d\x83\x78\xc6\x6a\x25\x25\x1a\x06\x46\x34\x38\x63\x4a\x32\x58\x09\xdd\xe1\x8c\x6d\x5a\x1e\x4b\x17\x91\x71\xe7\xb3\x68\xbe\x9b\x37\x8a\xd8\xd8\x42\xe6\x1d\x91\x35\x30\x1c\x56\x18\x7a\xc1\x16\xfe\xed\xda\xd3\x73\xe8\x3c\x4f\xf1\x9e\x5e\x4f\x04\x8f\x9d\xe5\x90\xe5\x4d\x07\x3a\x20\x79\x4d\x0f\xca\xaf\x64\x39\x0d\xec\xfe\xff\x49\xf2\xe3\xa8\x19\x4d\xfc\x31\x38\xfb\xd5\x3c\xff\xcb\x22\x7b\x3a\xaf\x4f\x23\xf1\xfc\x60\x6a\xf3\xfc\xe0\x5e\x33\x66\x7a\x67\x23\x6f\x8b\x8f\x76\xb8\xe9\x65\x4f\x6e\x4a\x8f\x26\x36\x37\xda\xf1\x6f\xe8\xa6\x93\xb1\x2b\xbe\x29\x49\xad\x30\x5d\xb7\x7d\xe9\x54\x4b\xc5\x04\x3c\xd2\x67\xd5\xa0\xed\xe8\x72\xd1\x99\x21\xe9\x97\x57\x10\x40\xdb\x52\xa4\x8f\xcc\xa1\xb6\x42\x5e\x5e\xdd\x43\xbc\x86\xdb\xe9\x74\x7a\x75\x3f\xe1\xf9\xb8\xff\xd1\x78\x08\x80\x46\x42\x8c\x93\x7f\x06\x00\x9e\x0f\xdf\x64\xe3\x0b\x00\x00")
+
+func staticTmplJobsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplJobsTmpl,
+		"static/tmpl/jobs.tmpl",
+	)
+}
+
+func staticTmplJobsTmpl() (*asset, error) {
+	bytes, err := staticTmplJobsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/jobs.tmpl", size: 3043, mode: os.FileMode(420), modTime: time.Unix(1792401042, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplListTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\xdb\x6e\xe3\x36\x13\xbe\xcf\x53\x0c\x08\xff\xd8\xbf\xc0\xda\x4a\xb7\x68\x0b\x38\x92\x8a\x02\xd9\xa0\x41\x37\x05\x8a\x1a\xbd\xe8\x1d\x2d\x8e\x64\xc2\x12\xe9\x92\x63\x27\xae\xa0\x77\x2f\x48\xd1\x8a\x22\x5b\x6e\x4e\xbb\x2b\x40\x36\x67\x86\xf3\x7d\x73\x22\x9d\xba\x06\x81\xb9\x54\x08\x2c\xd3\x8a\x50\x11\x83\xa6\xb9\x88\x97\x06\xa2\xf4\x22\x16\x72\x07\x59\xc9\xad\x4d\x58\xc6\x8d\x60\x60\x69\x5f\x62\xc2\xee\xa5\xa0\xd5\x1c\x7e\xfc\xfe\x7f\x57\x50\x71\x53\x48\x35\x07\xbe\x25\x7d\xc5\xd2\x0b\x00\x80\xa1\xe1\x54\xc8\x9d\x14\x68\x82\xd8\x3d\x77\x28\x24\x87\xb5\xd2\xf7\x0a\x48\xc3\x82\x17\x66\x0e\x75\x3d\x5b\x68\xe2\x65\xd3\x78\xbd\x38\x12\x72\x37\xb2\xa3\xc5\x8c\xa4\x56\xbd\x1d\xe3\x5c\x9b\x0a\x2a\xa4\x95\x16\x09\x2b\x90\x18\x70\xaf\x93\xb0\xa8\x94\x96\x7a\xaa\xc3\x1d\x0b\x23\xc5\xf4\x01\xfc\x6b\xc3\x85\x90\xaa\x98\x3e\x0c\xf4\x87\x36\x15\x0a\xb9\xad\xa6\xdf\x41\x86\x65\x79\x42\xd7\x3d\x71\xc9\x97\x58\xa6\x7f\x68\x43\xf3\x93\x0a\xee\x89\x2d\x96\x98\x11\x28\x5e\x61\xc2\xac\x36\x43\xa4\xc3\x7f\xb1\xde\x38\x5a\xb0\xe3\xe5\x16\x13\x96\xcb\x12\x9d\x2d\x83\xba\x96\x39\xe0\xdf\x30\xfb\x7d\x8b\x66\x3f\x73\x6e\xe1\x51\xdc\x34\xad\x23\x14\x75\x8d\x4a\x34\x4d\x7a\x13\x44\x71\xd4\xee\xf8\x22\xb7\x24\xa9\x1c\xf3\xd9\xca\x8e\x1d\x2e\xdc\xfa\xab\xbc\x09\x4e\x63\xce\xbc\xe8\xd8\xd7\x35\xa7\xd7\xb9\xaa\xb4\x90\xb9\x44\x31\xe2\xae\x13\x1f\xbb\xfc\xc2\x2d\xc1\x5d\x90\xff\xb7\xef\x38\x6a\x37\x38\xad\x11\x47\x6d\xf1\x9c\x16\xb6\xb2\x58\xaa\xcd\x96\x80\xf6\x1b\x4c\x58\xb6\xc2\x6c\xbd\xd4\x0f\x2c\x54\x92\x36\xae\xe3\xba\x00\xa2\xcd\x02\xa3\x40\xe7\x1a\x6d\xd6\x34\xde\xaa\xa3\x00\x51\x0a\x6e\x1d\x95\xeb\x81\x51\x08\xbd\xc6\x7c\x87\xf6\xf8\x85\x5b\x20\x5e\x9c\xe9\x90\x3e\x4d\xc2\x07\x3a\x50\x24\x5e\x74\x04\xeb\xda\x70\x55\x20\x4c\xe4\x47\x98\x10\xcc\x93\x43\xda\x16\xbc\xb0\x4d\xe3\x99\x4f\x64\xd3\x7c\x0c\x54\xeb\x7a\x42\x6e\xd9\x7f\x61\x6e\xdc\x1d\xdc\x3d\x3b\x0b\xbf\x4a\x25\x4e\xa3\xae\xeb\x29\x4c\xd6\x3d\x10\x4e\xd5\x86\xb1\x36\x12\x86\xb3\xc9\x5c\x4b\x25\x3a\xaa\x6e\x96\x6a\x06\x1d\xe3\x75\xa0\xe7\x3a\x03\x82\x74\x98\xd9\xf0\x72\x09\xfe\xd3\x29\x9c\x25\xf6\x62\x44\x7c\x2b\xe4\x38\xa2\x56\x7a\x06\xd1\xcf\x4e\xe1\x7d\x11\xc9\x8a\x17\x38\x8a\xa8\x95\x9e\x41\x74\xeb\x14\xbe\x76\xfd\xdf\x49\x6b\xa5\x1a\x29\x7c\x5f\x42\x55\xaf\x84\x82\xf6\x1b\x8a\xa8\x6a\x77\x60\x47\x23\x3c\xc4\xa8\x7a\x12\xa3\xc3\x10\x1f\x8d\x51\x98\xe6\x6f\xcc\xda\x10\x94\x40\x9b\x19\xe9\xc7\xf6\x28\xb4\xbe\xce\x19\x80\xd7\x8f\x6a\xef\x0f\x93\xd3\x78\xe8\xc2\x91\x34\x0e\x8c\xd3\xfb\x07\x8e\x78\x61\x47\x11\x79\xe1\x19\x44\x6e\x48\x7e\xed\x72\x0f\xa4\x6e\x8c\xae\x9e\x39\xeb\x7d\x1c\x03\xdd\xdc\xe8\xaa\xe3\xea\x99\x29\x4d\x87\xde\x70\x7b\xce\x6e\xed\x5f\x68\xb4\xa3\xd5\x5f\xbd\xd1\xa6\xe2\x04\xec\xd3\xe5\xe5\x0f\xd3\xcb\x6f\xa7\x97\x9f\xd8\x1b\xc7\x7e\xe0\xb1\xd0\xaf\x60\x41\x7a\x94\xc3\x42\x1f\x33\x58\xe8\x77\xc6\x7f\x22\x95\xa7\x96\xfa\xf0\xed\x76\x59\x49\x62\x87\x4c\x2f\xb7\x44\x5a\x75\x2c\x6e\x64\x49\x68\x9e\x00\x89\x23\x77\x13\xef\x7d\xd7\x03\x20\x6e\xb4\x85\x2a\x75\x87\x75\xee\x87\xdc\x67\x45\x46\xe2\xf0\x8c\x8c\x4b\xf9\xd4\xd6\xfd\x8f\x39\xac\x0c\xe6\x09\x8b\x36\x25\xdf\xa3\xf9\xc9\xdd\x71\x93\xba\x9e\xe4\xb3\xc3\x95\xb6\x69\x3a\xc0\xee\xee\x3f\xdd\x18\xdc\x49\xbc\x67\x20\x38\xf1\xa9\xd7\x67\x43\x83\x34\x96\x55\xf1\xc4\x8a\x56\xdb\x6a\xa9\xb8\x2c\x19\x58\x93\x25\x2c\xea\x16\x46\x5c\xf2\x92\x12\xc6\xa0\xd4\xdc\xdd\x9f\x12\x56\xf2\x7f\xf6\x0c\xb4\x42\x63\xb4\x49\x18\xad\xa4\x9d\xf9\xdf\x50\xb3\x9d\xb4\x72\x29\x4b\x49\x7b\x48\xe0\xc3\x4a\x0a\x81\xea\x83\x0b\x63\x6c\x37\x5c\x1d\x50\x58\xd2\x66\xbf\xd4\xdc\x88\x69\x6e\xdc\x25\x3e\x8d\x23\x27\x4f\xe3\x88\xbf\x36\x2e\xa9\x6f\x9e\x49\x3e\xf3\xf3\xdb\xd5\x52\xff\x33\x96\x16\x9b\x66\x60\x13\xca\xed\xa4\x53\x97\xcc\x76\xcc\x4c\x72\x7f\xcd\x39\x9c\xf7\x8f\xa7\xec\x31\xd0\x3e\x47\x5f\xa9\x60\x31\xd3\x4a\x70\xb3\x67\xa9\x77\xee\x76\x6a\x9a\xc0\xf6\xa4\x53\x0f\xe9\xa4\x44\xe6\xc0\x95\x80\x49\x3e\xbb\xbb\xbd\xfb\x0c\xff\x57\xf8\x88\xad\xc5\xf4\xcd\xf3\x40\xdd\x73\xa3\xfc\x88\xf5\x55\xb3\x55\x2e\xac\x7c\xd9\xd5\x8e\xdb\xdd\x15\x9a\x3f\x29\x13\xb6\x58\x49\x0b\x4b\xa3\xef\x2d\x1a\xc8\xb8\xfa\x40\xe0\x0c\xa0\xa7\x0b\xae\x6c\x6c\xf7\x3b\x5a\x48\xeb\x34\xe6\xa0\xb4\xc2\x2b\x96\xf6\x8c\x56\x68\xf0\x65\xec\xe3\x68\xd8\x2b\xc7\x7a\x71\xd4\xef\xc5\x10\xab\xd9\x6f\xf8\x40\x5f\xa4\x5a\xf7\x15\xf9\x21\x12\x6d\xbf\xf7\xf2\x13\x6a\xac\xae\x7b\x76\x2c\x75\x9f\x61\xe3\x2f\x4d\xbd\x22\x79\x8a\x20\x4c\x9a\xc3\xab\xfd\x23\x43\x5d\x03\x2a\x01\x4d\x73\xf1\xef\x00\xfc\x70\xd8\x73\x8d\x10\x00\x00")
 
 func staticTmplListTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -275,12 +385,12 @@ func staticTmplListTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/tmpl/list.tmpl", size: 428, mode: os.FileMode(420), modTime: time.Unix(1539974533, 0)}
+	info := bindataFileInfo{name: "static/tmpl/list.tmpl", size: 4237, mode: os.FileMode(436), modTime: time.Unix(1792400527, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _staticTmplMainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x52\x5b\x6e\xdb\x30\x10\xfc\xf7\x29\xb6\x8b\x7e\x24\x40\x29\xb6\x7f\x05\x4a\xea\x14\xbd\x00\x4d\xae\x2c\xba\x14\xa9\x90\x2b\x39\x82\x90\xbb\x17\xb6\xac\xc8\x76\x8b\xa2\xc8\x17\x5f\x33\x83\xe1\xec\xcc\x33\x38\x6a\x7c\x24\xc0\x60\xa6\x34\x30\xc2\xdb\xdb\x4e\x7d\x72\xc9\xf2\xd4\x13\xb4\xdc\x85\x7a\xa7\xce\x0b\xd8\x60\x4a\xd1\x18\x93\x38\x16\x84\x60\xe2\x41\x23\x45\xac\x77\x00\x00\xaa\x25\xe3\x96\xed\xe5\xd8\x11\x1b\xb0\xad\xc9\x85\x58\xe3\xc0\x8d\xf8\x8e\x20\x1f\x01\x2d\x73\x2f\xe8\x65\xf0\xa3\xc6\x57\x31\x18\x61\x53\xd7\x1b\xf6\xfb\x40\x08\x36\x45\xa6\xc8\x1a\x3d\x69\x72\x07\xc2\x47\x76\x34\x1d\x69\x1c\x3d\x9d\xfa\x94\xf9\x86\x70\xf2\x8e\x5b\xed\x68\xf4\x96\xc4\xe5\xf0\x05\x7c\xf4\xec\x4d\x10\xc5\x9a\x40\xfa\x5b\xf5\xf5\xde\x0e\x7b\x0e\x54\xff\x34\x87\xac\xe4\xb2\xdf\xde\x82\x8f\xbf\x20\x53\xd0\x58\x78\x0a\x54\x5a\x22\x46\x68\x33\x35\x1a\x65\x61\xc3\xde\x4a\x5b\x8a\x6c\xd2\x10\x9d\x61\x9f\x62\x65\x4b\x79\xd7\x57\x72\xcb\x46\xed\x93\x9b\x36\xe9\x79\x06\xa6\xae\x0f\x86\x09\x90\x53\x2f\xf6\x26\x23\x54\xe7\x19\xfc\x1d\x73\xfd\xe2\x23\x46\x15\x9b\x7d\xcf\x50\xb2\xdd\x2c\x1d\x8b\x1c\x29\xba\x94\xe5\xf1\x65\xa0\x3c\x55\xc7\x82\xb5\x92\x0b\xb4\xfe\x5f\xee\xa9\x35\x2c\x7c\xec\x07\xfe\x18\xff\x26\x94\xce\xc7\x7f\x6b\x6c\x17\xf0\xf9\xc9\x25\x3b\x74\x14\xf9\xb9\xda\x24\x9e\x9e\x7f\x6c\x9c\x3b\x15\x25\x97\x64\x95\x5c\x2a\x3b\xcf\x40\xd1\x5d\x42\xba\xe9\xf8\x7b\xc4\xe7\x92\x3b\x3f\xae\x9d\x5e\xef\xaf\x5a\x7f\xbe\x88\x40\x0d\xdf\x16\xd0\xac\xf3\xc7\x15\xd9\x51\x1c\x04\xd3\x2b\xe3\xb5\x46\x66\x75\xe6\xfc\x78\x36\x76\x59\x36\x5f\xbf\x03\x00\x00\xff\xff\x23\xc6\xd0\x4d\x7b\x03\x00\x00")
+var _staticTmplMainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\x4d\x8f\xe4\x26\x14\xbc\xf7\xaf\x78\xcb\x39\x6e\x6f\x6e\x39\x60\x5f\x22\xe5\x10\x45\x91\x92\x8d\x72\x7f\x86\xe7\x36\xbd\x18\x3c\xf0\xec\xd9\x16\xe2\xbf\x47\xb8\xbf\x67\xa7\x47\x9d\x95\x0f\xd0\x50\x55\x6d\xca\x55\x76\x4a\xa0\xa9\x37\x8e\x40\x58\x3c\xf8\x99\x05\xe4\xbc\x91\x9f\xb4\x57\x7c\x98\x08\x06\x1e\x6d\xbb\x91\x65\x00\x65\x31\xc6\x46\x38\x5f\xed\xa3\x00\x8b\x6e\xd7\x08\x72\xa2\xdd\x00\x00\xc8\x81\x50\x1f\xa7\xe5\x92\x23\x31\x82\x1a\x30\x44\xe2\x46\xcc\xdc\x57\xbf\x08\xa8\xdf\x02\x06\xe6\xa9\xa2\x97\xd9\x2c\x8d\xf8\x56\xcd\x58\x29\x3f\x4e\xc8\xa6\xb3\x24\x40\x79\xc7\xe4\xb8\x11\x86\x1a\xd2\x3b\x12\x6f\xd9\x0e\x47\x6a\xc4\x62\xe8\x75\xf2\x81\x6f\x08\xaf\x46\xf3\xd0\x68\x5a\x8c\xa2\x6a\xfd\xf1\x13\x18\x67\xd8\xa0\xad\xa2\x42\x4b\xcd\xcf\xdb\xcf\xf7\xb7\xc3\x86\x2d\xb5\xff\xe0\x2e\xc8\xfa\x38\xbf\xee\x59\xe3\xbe\x42\x20\xdb\x88\xc8\x07\x4b\x71\x20\x62\x01\x43\xa0\xbe\x11\x75\x64\x64\xa3\x6a\x15\x63\xdd\xfb\xd9\x69\x64\xe3\xdd\x56\xc5\x78\xaf\xff\xac\x06\x4e\xd3\x1d\x59\xd6\x57\x63\x65\xe7\xf5\xe1\xaa\x99\x12\x30\x8d\x93\x45\x26\x10\xec\xa7\xaa\xc3\x20\x60\x5b\x1e\xe0\xfb\x98\x93\x3f\x6f\x31\x32\xaa\x60\x26\x86\x18\xd4\xf5\x5e\xf6\xb1\x5e\xc8\x69\x1f\xea\xfd\xcb\x4c\xe1\xb0\xdd\x47\xd1\xca\xfa\x08\x6d\x9f\xe5\xbe\x0e\xc8\x95\x71\xd3\xcc\x3f\xc6\xbf\x71\x74\x34\xee\x7f\x6a\x14\x2b\xbf\x67\xc8\xfa\xe8\xa2\xac\x4b\xa8\xdb\x4d\x4a\x40\x4e\xaf\xa6\xdd\x94\xe1\x62\x67\x69\x83\x36\xcb\x39\xfc\xe7\xf5\x93\xd6\xf7\x3b\x95\xa5\x9e\x6f\x93\x3a\x5f\x8a\x33\x92\x9b\x6f\x76\x4e\xb1\x68\x25\x9e\x63\x20\x6e\x91\x15\xd3\x37\x16\xa7\x44\x62\x2b\x6b\x6b\x3e\xe2\x32\xee\xe2\x8a\x8e\xcf\xa0\xc3\x6c\x29\x8a\xf6\xef\x32\x3c\x83\x2f\x4d\x8b\x13\xaa\x42\xfa\xf3\x32\x7f\x86\xd9\x1b\xb2\x3a\x8a\xf6\xb7\x75\x7c\x86\xb1\x78\x85\x9d\x68\xff\x2d\xc3\x6c\x31\x1c\x9e\x21\xed\x7d\x17\x45\xfb\xbb\xef\x1e\x1c\x27\xa5\x0a\x02\xba\x1d\x41\xc4\x85\xf4\x17\xc2\xa0\x06\x8a\x39\x3f\xd6\x4c\x69\xfb\x87\x71\x5f\x73\x16\xb0\xbe\x10\xd6\x95\xbf\x4a\x17\x72\x16\x6d\x4a\xdb\x62\x44\xce\x20\xe3\x84\xee\xfc\xe4\x3a\xd4\xe5\x3f\x48\x79\xa7\x31\x1c\x0a\xce\xf4\x60\x19\xb6\xbf\xfa\xd9\x31\x7c\xce\xf9\x53\x4a\x64\x23\xe5\x9c\xd2\x71\xb1\xcc\xc8\xe9\x9c\x65\x5d\xa4\xda\xc7\x27\x58\x51\x97\x55\x59\xcf\xf6\x9c\x69\x6d\x96\xc7\x91\x0c\x66\x37\xdc\x65\xb2\xf7\x61\x84\x91\x78\xf0\xba\x11\xbb\xf2\x22\x42\x55\x4a\x56\xea\xb3\x3a\xf3\x36\xa6\x1f\x86\xf8\xe2\xdc\x5a\x74\x28\xdf\x8d\x46\x9c\x74\xa0\x24\xa7\x11\x2f\x02\x26\x8b\x8a\x06\x6f\x35\x85\x46\x7c\x39\xed\xd6\xef\x1c\xf4\x7d\xb9\xb9\x1b\x0d\x5f\x0a\xd2\xcd\xcc\xde\x09\x58\xd0\xce\xf4\xb1\xdc\xd5\xa5\x72\xc9\xba\x9c\xfd\xce\xb5\xd3\x90\x12\x90\xd3\x90\xf3\xe6\xbf\x01\x00\x6f\x55\x18\xec\x14\x07\x00\x00")
 
 func staticTmplMainTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -295,12 +405,32 @@ func staticTmplMainTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/tmpl/main.tmpl", size: 891, mode: os.FileMode(420), modTime: time.Unix(1539974244, 0)}
+	info := bindataFileInfo{name: "static/tmpl/main.tmpl", size: 1812, mode: os.FileMode(436), modTime: time.Unix(1792399427, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _staticTmplPlyrTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x4d\x6f\xe3\x36\x10\xbd\xeb\x57\x4c\x09\x2c\x24\x03\x89\xb4\x28\x1a\xb4\x9b\x58\x2e\xd0\x4d\xb6\xbb\x45\x77\x53\x34\x2e\xd0\x2b\x2d\x8e\x14\xa6\x34\xa9\x92\x54\x12\xc3\xf0\x7f\x2f\x86\xb4\x23\x29\x71\x3e\x7c\xab\x2e\xa6\xcd\x79\x8f\x6f\x66\x1e\x47\x5e\xaf\x41\x60\x2d\x35\x02\xab\x8c\xf6\xa8\x3d\x83\xcd\x26\x99\x2e\x2c\x14\xb3\x64\x2a\xe4\x2d\x54\x8a\x3b\x57\xb2\x8a\x5b\xc1\xc0\xf9\x95\xc2\x92\xdd\x49\xe1\xaf\x4f\xe1\xc7\x93\x77\x67\xb0\xe4\xb6\x91\xfa\x14\x78\xe7\xcd\x19\x9b\x25\x00\x00\x8f\x81\xc7\x42\xde\x4a\x81\x76\xbb\x4d\xcf\x7a\x2d\x6b\xc8\xe7\xd2\x2b\xdc\x6c\xd6\xeb\x7e\x85\xca\xc5\x5f\x3e\x49\x85\x9a\x2f\xe3\x8f\x5a\x6c\x36\x91\xba\x10\xf2\xf6\x99\x53\x1c\x56\x5e\x1a\x0d\x1e\xef\xfd\x71\x85\xda\x8f\x4e\x9c\x92\x04\x03\x94\xa7\x35\x8a\x30\xdb\x15\x83\x90\x4f\xc9\x3e\x9c\xbc\x1b\xc4\x07\x8c\x33\x9d\xad\x10\x9c\xad\x4a\x56\x04\x82\xe3\x5a\x2a\x2c\x46\xfa\x18\xf8\x55\x8b\x25\x0b\xfb\xc5\xb2\xfd\x81\x51\xf5\x1e\x38\x22\x6e\xb6\x57\xbe\x14\x25\x5b\xa2\xe7\x0b\x73\xcf\xf6\xe5\x32\xd4\x5f\x1b\xbb\x0c\x80\x06\x35\x5a\xae\xbe\xa2\xe7\x8f\xf5\x2a\xbe\x40\x35\x0b\xc5\x3c\x1d\xed\x84\x5d\xa9\xdb\xce\x6f\xc5\x52\x91\x58\xa0\xf3\x14\x3d\x92\x1c\x95\x46\xae\x7d\xfc\xe7\xdc\xbf\x46\x2f\xb8\xc7\x48\x1f\x57\x87\xb0\xa3\xab\xac\x6c\x29\xfd\x3d\x87\x90\x6e\x6e\x91\x47\xee\x3e\x94\x41\x15\xba\xfa\xe1\x27\x06\xd6\xdc\xb9\x92\x9d\x30\x98\x4d\x8b\x5d\xfc\xdb\xcf\x9f\xf3\xc6\xbd\xbd\x78\xbc\x71\xaf\x67\x37\x2d\xa8\x79\x83\xef\x8b\xce\x7b\xa3\x77\x1d\xdf\x7e\x6b\xad\x5c\x72\xbb\x62\x60\xf4\x47\x25\xab\x7f\x4a\xe6\x50\x8b\x4f\xc6\x2e\xb3\x09\x9b\xfd\xd5\x52\x25\xa7\x45\x0c\x1e\xd9\x69\xf7\x11\xaf\x6d\x32\x8d\x45\x99\x25\x50\x77\x3a\x5e\x89\x2e\x80\x23\x15\xac\xa3\x8e\xfb\x6b\x0b\x25\x68\xbc\x83\xbf\xbf\xfe\xfe\xd9\xfb\xf6\x4f\xfc\xb7\x43\xe7\xb3\xc9\xd9\x43\x40\x6e\xd1\xb5\x46\x3b\x9c\xaf\x5a\x84\x12\xd2\x1b\x67\x74\x3a\xd8\x37\xda\x22\x17\x2b\xe7\xb9\xc7\xea\x9a\xeb\x86\xa2\x76\xc7\xf6\x67\xd1\x23\x6b\xc8\x22\x25\x17\xab\x2b\x02\x40\x59\x96\x8f\x0e\xcf\xcf\x2f\xbf\x5d\x8c\x60\x43\x28\x1d\xd3\xb9\x00\xfb\xfe\xfd\xfb\x27\x61\xf4\x08\x53\x75\x4b\xd4\x3e\x6f\xd0\x5f\x28\xa4\xe5\x2f\xab\x2f\x22\x4b\x83\xcd\xd3\x49\x7e\xcb\x55\x47\x22\x87\xd9\xc5\xf1\x73\x76\x00\x1b\x95\xf3\x39\x32\xba\x1e\x07\x71\xf5\x2e\x7e\x96\xb2\x0f\x39\x84\x99\xdc\xf9\x6c\xca\xbc\x71\xf9\x8d\x91\xfa\xa1\xdf\xbb\x67\x03\x34\x84\xf7\x15\x97\x2b\xb4\x3e\x4b\x3f\x9a\x4e\x09\xd0\xc6\x83\x59\x78\x2e\x35\xd0\x4c\x04\x9a\x63\x82\x7b\x9e\xa7\x4f\x08\x93\xc7\xcb\xcd\xc0\x41\x2d\xea\x2c\xfd\xf5\x62\x9e\x1e\x41\x5a\x48\x5d\x9b\x9f\x89\xae\x1c\x8d\xd8\x74\xd2\x03\xe8\x52\x64\x93\x84\x38\x06\x0e\xef\xaf\xca\x4e\x38\x69\xd9\x1a\xfc\x72\x71\x83\x55\x6f\xec\xa0\x72\x47\x0e\x25\xb0\xf1\x38\x1f\x46\x05\x63\x40\xf9\x46\x5b\x0d\x91\xe7\xc1\xe0\x6f\x73\xd0\x08\xd7\xb7\xfa\x45\xf8\x53\xd3\x8c\x74\xf3\xc6\xbd\x28\x7b\xe0\x8c\xdc\xb5\x4a\xfa\x2c\x3d\x7a\x68\x5c\x65\xb4\x33\x0a\x73\x65\x9a\x8c\xe8\x06\x13\xe1\xf5\x91\x11\x1a\xca\xfe\xb8\xbc\x9a\xb3\x23\x60\x45\x1c\x3d\x7b\x7a\xca\x8e\xc0\xdb\x0e\x87\x50\x87\x7e\xcb\xf8\x19\xb9\x40\x4b\x56\x0b\x7f\x4b\x8e\x69\xfe\x90\x41\x78\xdb\x2a\x59\x71\x4a\xbb\x08\xb3\x68\x0c\xd7\x22\xfb\xed\xea\xf2\x5b\xee\xbc\x95\xba\x91\xf5\x2a\xca\x9f\xfc\x9f\x27\xd6\xb0\xd8\x2c\x4e\x79\xb8\xea\xaa\x0a\x9d\xab\x3b\xc5\x0e\xbd\x9d\xec\x8b\xa6\x97\x4d\x28\x11\x6c\xe9\x6a\x2e\x15\x8a\xef\x9e\x72\xed\xbb\x98\x9b\x24\x19\xbd\x2f\x92\x69\xb1\x7b\xa1\xac\xd7\x80\x5a\xd0\x5f\xc4\xff\x02\x00\x00\xff\xff\xfe\x38\xce\x85\x3a\x0a\x00\x00")
+var _staticTmplNamespacesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x55\x4d\x6f\xdc\x36\x10\xbd\xfb\x57\x0c\xe8\x24\x87\xc2\x5e\x39\x29\x8a\xa0\x6b\x69\x8b\x22\xed\xad\xe8\x25\x45\x2f\x86\x0f\x23\x71\x56\x62\x43\x91\x0a\x39\xda\xad\xc1\xf2\xbf\x17\xa4\xa4\x5d\xad\x3f\x20\x02\xb2\xe8\x37\x6f\xde\xbc\x19\x72\x43\x00\x49\x7b\x65\x08\x44\x63\x0d\x93\x61\x01\x31\x5e\x95\xb5\x83\x62\x77\x55\x4a\x75\x80\x46\xa3\xf7\x95\x68\xd0\x49\x01\x9e\x9f\x34\x55\xe2\xa8\x24\x77\x5b\xf8\xfc\xd3\xfb\x7b\xe8\xd1\xb5\xca\x6c\x01\x47\xb6\xf7\x62\x77\x05\x00\xf0\x3c\xf0\x56\xaa\x83\x92\xe4\xe6\x7f\xa7\xf5\x17\xb6\x60\xb0\x27\x3f\x60\x43\x3e\x6f\x97\x85\x54\x87\x37\x08\x3c\x35\xac\xac\x59\x11\x94\xc3\xf9\xef\xf4\xfc\xb9\x90\x49\x60\x6c\x3d\xa0\x23\x38\x3a\xc5\x4c\x06\xca\xc6\x4a\xda\x9d\xd2\x6d\x0f\xa8\x47\x2a\x8b\xbc\x7b\x03\x7e\x6c\x3a\x40\x7f\xc1\x36\x45\x0c\xe4\xbc\x35\x5b\xd4\xaa\x39\xc3\xd1\xc8\x4c\xee\x09\x5d\xd3\x91\x04\xee\x08\x3c\xf6\x04\x47\x7c\xda\x00\xfc\x36\x19\x6a\x0d\x5d\x30\x9e\xb2\xc3\x40\x0e\x74\x82\xa0\x5f\x29\x83\x87\xc6\x6a\xeb\x1e\xe1\xc1\x2b\xd3\x6a\xfa\xaf\x1f\x35\xab\x47\x78\xa8\x20\xcb\xbd\x99\x5e\x8f\xb3\x8e\xcd\x05\xf9\xaf\x33\xd1\x14\x3a\x43\xce\xfe\x02\xe3\x37\xf2\x80\x0c\xbd\xf5\x0c\xd6\xd0\x44\x96\xa5\xa4\xce\x58\x40\x23\x2f\x18\xb5\xf2\xac\x4c\x3b\xe1\x3c\x38\xf2\xec\x54\xc3\x3e\x57\xbb\x22\xb6\x69\xa3\xdf\x00\xfc\xa1\x0c\x79\xf0\x8c\x2e\xc7\x1d\x15\x77\x17\x84\x93\xc0\xeb\x45\x5b\x72\x50\xb5\xc6\x3a\x92\xe7\x52\xca\x62\xd5\xd4\x10\x6e\x41\xed\x61\xf3\xbb\x73\xd6\xc5\x78\x06\x5d\xcc\x86\xd6\x76\x64\x40\x4d\x8e\xc5\x2e\x84\x05\xbd\x9a\xa5\x85\x8b\x8c\x5c\xb3\xec\xad\xeb\xa1\x27\xee\xac\xac\xc4\x60\x3d\x0b\xc0\x3c\x63\x95\x28\x4e\xf5\xf9\xd5\xc0\xa5\x55\x32\xfd\xcb\xe8\x08\xb3\xb7\x95\x58\x01\xc1\xd9\xa3\xaf\xc4\xc7\x3b\x01\x83\xc6\x86\x3a\xab\x25\xb9\x4a\x4c\x33\x04\xd7\xcd\xdd\x8f\x3f\x7f\xaa\x21\x77\xf5\xc3\xf5\xc7\xbb\xfb\x8c\x82\xeb\x4f\xf8\x19\xeb\x3b\x98\x3a\x07\x15\x74\xb6\xa7\x1b\xb0\xfb\xbd\x6a\xe8\x06\x34\xd6\x13\xd8\xd9\x7f\xa8\x99\x4a\xfc\x62\xcd\x5e\xb5\xa9\xc6\x45\xcd\x33\x91\xca\x0c\x23\x03\x3f\x0d\x54\x09\x3f\xd6\xbd\x62\xb1\x18\x56\x8f\xcc\xd6\x88\xa9\xad\x95\xf8\x8a\x87\x55\x37\xbd\x48\xa7\xfe\x44\x53\x24\x8b\x56\xdf\x8c\xb5\xa6\xf3\xf7\xe2\xab\x43\xd3\xae\x49\x56\x26\xa7\x55\xb2\xbb\x8c\x49\x4f\xc9\x72\x57\xfa\x01\xcd\xa2\x4b\x63\x4d\x5a\x40\x08\xa9\xe3\x5f\xd2\x41\x88\x71\xbe\x6c\x6a\x6c\xbe\xb5\xce\x8e\x46\xde\xe6\x13\xb2\x85\x10\x16\xc8\xbd\x08\x21\xf7\x35\xf9\x92\x6e\x80\xe4\x4a\xe2\xdd\x95\x05\xcb\xd7\xf3\x4e\x39\xbe\x66\xbf\x63\x9c\x7d\xcf\x7e\x84\x40\xda\x53\x8c\xb9\x47\xc3\xb2\xeb\xe7\x14\x6f\x33\xbe\xd8\x4c\xab\x44\xe8\x1c\xed\x2b\x51\x4c\x17\xc5\x2f\xdf\xab\x93\xc6\xed\x0f\x62\x87\x5a\x97\x05\xbe\x1e\x9b\x6c\x7d\x67\x3c\x6c\x2b\x98\x23\xde\x84\x4d\xee\x6f\xfe\xce\x4a\x63\x84\x0f\xbd\x92\xd2\xf2\xfd\xeb\xf9\xdf\x19\x1f\xe3\x36\x84\xc1\x29\xc3\x7b\x10\xef\xbf\x0b\xd8\xc4\x98\xc7\x2a\x59\x87\xbb\xb9\xd8\x17\xf9\x5e\x56\x5f\x16\xcf\x3b\xfb\xca\x29\x2b\x56\x43\x33\x9f\xc9\xe5\x35\xfd\xc6\x84\x00\x64\x24\xc4\x78\xf5\xff\x00\x39\x71\x8c\x1b\x8c\x06\x00\x00")
+
+func staticTmplNamespacesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplNamespacesTmpl,
+		"static/tmpl/namespaces.tmpl",
+	)
+}
+
+func staticTmplNamespacesTmpl() (*asset, error) {
+	bytes, err := staticTmplNamespacesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/namespaces.tmpl", size: 1676, mode: os.FileMode(420), modTime: time.Unix(1792398457, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplPlyrTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x19\xdb\x72\xdb\x36\xf6\x5d\x5f\x71\xc2\x99\x86\x54\x2b\x51\x6e\x67\xba\x3b\x1b\x8b\xce\x6c\x1c\x67\x9d\x8e\xd3\x64\x22\x77\xb6\x0f\xfb\x02\x11\x47\x12\x6a\x08\x60\x00\x50\xb2\x56\xe5\xbf\xef\x1c\xf0\x22\x92\x92\xec\xb4\x7d\x58\x91\x33\x26\x09\x9c\xfb\x1d\xde\xef\x81\xe3\x42\x28\x84\x20\xd5\xca\xa1\x72\x01\x14\xc5\x60\x3a\x37\x30\xb9\x1a\x4c\xb9\xd8\x40\x2a\x99\xb5\x49\x90\x32\xc3\x03\xb0\x6e\x27\x31\x09\xb6\x82\xbb\xd5\x2b\xf8\xfb\x8f\xdf\x5c\xc2\x9a\x99\xa5\x50\xaf\x80\xe5\x4e\x5f\x06\x57\x03\x00\x80\x3e\xe0\x98\x8b\x8d\xe0\x68\xaa\x65\xba\xf7\x7b\xb1\x80\xf8\x5e\x38\x89\x45\xb1\xdf\x1f\x9e\x50\xda\xf2\xcb\x3b\x21\x51\xb1\xb5\x7f\x41\xc5\x8b\xc2\xc3\x4e\x27\x5c\x6c\xce\x50\xb1\x98\x3a\xa1\x15\x38\x7c\x74\xe3\x14\x95\xeb\x50\x9c\x12\x0b\x1a\x04\x4f\x82\x4c\xb2\x1d\x9a\x00\x48\x66\xa3\x25\x89\x57\x3d\x05\xe0\x65\x4b\x82\x7f\xfc\xf8\x4d\x00\x99\xb6\x0e\x4d\x12\x4c\xdc\x2a\x5f\xcf\x15\x13\xf2\xf5\x42\x48\x4c\x3a\xdc\xb5\x48\xd0\x3d\xb5\x3a\x37\x29\x82\x35\x69\x12\xec\xf7\xf1\xcc\xbf\x16\x45\x50\x8b\xbc\xcb\xb0\x28\xdc\x2e\x43\xbf\x5c\xbe\x06\x95\x88\xa4\xf5\x1a\x11\x5d\xfb\xfd\x18\x0c\x53\x4b\x84\x78\x96\xcf\x1d\xe9\xc8\x16\x45\x67\xcb\xd4\x19\x96\x3e\xc0\x83\x50\x9c\x10\x92\x56\x67\x6f\x6f\x8b\x22\x65\x19\x29\xc3\xd6\x1a\xb5\x35\x7c\x45\x2a\x00\xc9\xe6\x28\x09\x26\xbe\xa3\x27\x62\xd1\x33\x3d\x69\xb6\x76\xa4\x3d\x88\x70\xc7\xd4\xb2\x28\xac\x49\x25\x53\xcb\x0a\x81\x5a\x3e\x2d\xc5\xc1\x82\xf5\x8f\xbe\x12\xb6\xeb\x15\xcb\x1c\x9a\x27\xe5\x4a\xab\x3d\x0d\xd3\x35\x50\xcd\x72\xbd\xe1\x84\x7d\x5e\xb2\x75\x76\xb9\xd0\x66\xcd\x5c\xb2\x71\x2e\x20\x8f\x67\xb9\x74\xcf\x73\x39\x9d\x78\x97\x39\x6c\xf3\x1e\x47\x0e\x64\x53\x93\xcf\xdf\x30\x72\xa1\xd2\xff\xfc\x87\xf1\x9c\x99\x26\x46\xb8\xb0\xe4\x66\xaf\x40\x69\x85\x75\x60\xd4\xbf\xb6\xeb\x66\x46\x2f\x0d\x5a\x3b\x5e\x08\x29\x83\xab\x96\x83\x9f\xda\x6d\x9d\x36\xbb\xb9\x66\x86\x8f\x17\x86\xad\x31\xb8\x9a\xda\x8c\xa9\x66\xd9\xf3\xe1\x84\x5f\x98\xd0\x4a\x1f\x61\xef\x95\xa4\x76\xb8\xce\x24\x73\x08\xc1\x1a\xb9\x60\x6f\x18\xe9\x35\x6e\x2b\x82\x10\xf9\xc8\xb1\xce\x20\x5b\x7b\x7f\x69\x64\xf7\x26\x01\x8b\xa9\x56\x9c\x99\xdd\x59\x0d\xcc\x3c\xac\x50\x4b\xd0\x1b\x34\x70\x7b\x37\xab\x38\xec\x30\x43\x2e\x71\xcb\xec\x27\xa3\x1f\x77\x45\x71\xb4\xf4\x49\xb2\x5d\x7f\xad\xa3\x81\x3e\x37\x57\x04\x41\x44\xdd\x0a\xc1\xe0\x46\xe0\x16\x32\x42\x50\x11\x87\x29\x83\x95\xc1\x45\x12\x4c\x88\x5b\x34\xe7\x5c\x48\x1b\xb1\x14\x8a\xc9\xe4\xfb\x12\x27\xd4\x1f\xa6\x13\xd6\x15\xa1\x0c\xb8\x3f\xc1\xde\x01\xe3\x57\xb3\x56\xf1\x52\x49\xd4\x67\xa4\xe3\xce\x35\x6b\xa5\x1e\x9f\xd7\xa1\x0f\x76\xfc\x52\xed\x8d\x67\x8e\xb9\xdc\x42\xb0\x60\x42\x22\x0f\x8a\x82\x49\x34\xae\x49\x2f\xb5\x44\x4d\x7a\xf1\xd9\xc6\x67\x87\x12\xfe\xc6\x18\x6d\x8a\x22\xb8\xf2\xaf\xd0\x7c\x2f\xf1\x16\x45\x25\xf4\x33\x02\x88\x05\x30\xc5\x21\xfe\xf0\xfe\xc3\x0d\x44\x4a\xbb\x96\x4b\x0c\xdb\xf2\xb4\x62\x26\x65\x52\xea\xdc\xc1\x96\x19\x25\xd4\x32\x00\xce\x1c\x1b\xe7\x8a\x94\xca\xe6\x15\x93\x84\xb0\x28\xce\x3a\x6f\x83\x98\xee\xfb\x95\xb0\x30\x37\x7a\x6b\xd1\x40\xca\x54\xe8\x80\x36\x43\x83\x06\xc8\x87\xec\xa8\x65\x40\x9f\x49\x28\xc4\x71\xd2\xb5\x1f\x70\xbd\x55\x52\x33\x7e\x55\x3f\x78\x57\xa0\x9d\xe4\x58\xe0\x34\x6c\x99\x4b\x57\x20\x5c\xfc\x54\x10\x1f\x54\xd5\x5a\x3c\x57\x22\x5b\xf2\xb4\xb7\xf8\xf0\x1f\xeb\xf9\x6f\x98\xba\x9e\xc8\xe7\xb6\x9d\xc0\x58\x5f\x53\xb1\x5e\xfa\xa4\xd1\x54\xcf\x26\x65\xb4\xbe\x94\xf9\xbb\xf9\x70\xca\xc5\x81\x49\x97\x04\x01\x68\x85\xe4\x43\x49\xe0\x56\xc2\xc6\xde\x50\xf1\x46\x58\x31\x17\x52\xb8\x1d\x24\x10\xae\x04\xe7\xa8\xc2\xa0\x9f\xdb\x7b\xfa\xfa\x1a\x81\x60\x2d\x38\x97\x78\x4a\xae\xec\xea\xbe\x66\xd7\xc7\x48\xfc\xc9\xf7\x09\x64\x76\xa3\xd7\xb0\xdf\x53\xfe\x4d\x35\xc7\xc3\x4a\x15\x15\xd3\x49\x76\x02\x9f\x50\x59\xee\xa0\x6c\x09\xe6\xb9\x73\x5a\x35\x8a\x2a\x5f\x5b\xf9\x02\x36\x4c\xe6\x98\x04\xbf\x58\x84\x34\x37\x06\x95\x83\xb2\x10\x80\x56\xd7\x52\xa4\x0f\x49\x60\xd1\x35\x0c\x46\x5c\xa7\xf9\x1a\x95\x8b\x97\xe8\x6e\x24\xd2\xe3\x9b\xdd\x7b\x1e\x85\xe4\xb1\x68\xc2\x61\x5c\xa1\xb9\x17\x6b\x1c\x1e\x69\xee\x2f\x31\x58\x55\xda\x73\xac\x5d\x0c\x9f\x33\x54\xeb\xb5\xf5\xd8\xaf\x59\x8e\x51\x40\x1f\x4a\x56\x77\x9d\x8c\x21\x85\xc2\x72\xbd\x42\x53\x75\xb8\x83\xfd\xfe\x04\xa6\x59\x6a\x44\xe6\x02\x88\xa9\x17\xde\xef\x4f\xe0\xea\xec\x98\x5a\xff\x76\x35\x80\xc9\x04\xda\x02\xc2\x97\x1c\x73\xb4\xc0\xe0\x37\x3d\x87\x35\x7b\xa8\xf3\xbc\x37\x18\x30\x07\xce\xc7\x7a\xe3\xfc\x23\xd0\xc6\x63\x59\x6a\xda\x3a\xa7\xc6\xce\x69\xbf\xa7\x6e\x5a\x4a\xd0\x85\x36\xf0\x5f\x34\x7a\xe4\x93\xa1\x5d\xe9\xad\xf5\xbb\x14\x6e\x3d\x7c\x83\x11\xb4\x4a\x11\x84\x03\x61\x81\x6b\x85\xf1\x00\x16\xb9\x2a\xdb\xe4\x8e\x2d\xdc\x10\xf6\x5e\xb9\xb0\x61\x06\x1e\x57\x06\x12\x50\xb8\x85\x5f\x3f\xdc\xdd\x3a\x97\x7d\xc6\x2f\x39\x5a\x17\x0d\x2f\xcb\x4d\x8f\x2b\x13\xeb\x0c\x55\x14\x7e\xfa\x38\xbb\x0f\x47\x10\xf6\x43\x38\x84\xef\x00\x15\x45\xc1\x2f\x9f\xdf\x5f\xeb\x75\xa6\x15\x2a\x17\x85\x9d\xd0\x0e\x87\x23\x70\x26\xc7\x0e\x5a\x65\x90\xf1\x9d\x75\xcc\x61\xba\xf2\xcd\x6f\xd2\x30\x1d\x35\x6c\xd2\x2d\x16\x10\x11\x88\x07\xa0\x3a\x82\x90\x24\x49\x8f\xe7\xf8\xed\xc7\x9f\x6f\x3a\x60\x6d\x50\x22\x93\x5b\x0f\xf6\xc3\xc5\x0f\x47\xdb\xe8\xde\x32\xe1\xde\x69\xf3\x93\x9e\x47\x3f\xcd\x3e\xfe\x1c\x67\xcc\x58\xac\xe8\xda\x4c\x2b\x8b\xf7\xf8\xe8\x86\xa3\x33\x4c\xb6\x2f\xd2\x2d\x65\xc5\x04\xce\x86\x65\xa3\xc6\xb0\x56\x4a\xff\x27\xd6\xcb\xd8\x9a\x14\x92\x3f\xad\x74\xf8\x0e\xc2\x97\x1b\xbf\xfb\x2d\x73\x18\x2b\xbd\x6d\x2c\xdb\xbf\x3c\xb5\x13\xa9\xd6\x27\x5e\x89\xe1\x13\x60\x19\xa3\xf4\xf4\xb3\xe6\x18\x2b\x7c\xac\xc5\x9c\x89\xb9\x14\x6a\x19\x2f\x84\xb1\xf5\xb7\xeb\x95\x90\x3c\xa6\xb9\xed\xba\x1c\x43\x21\x39\x8d\x96\x2e\x07\xaf\x21\x3c\x04\x99\xcf\xb9\x24\x4a\xd9\xe3\x53\x1a\x8b\xdc\x10\x5e\xb5\xf6\x9c\x62\xb2\xe8\x0b\x5c\x00\x75\x32\xa7\x2c\xe7\x1b\x9d\x28\x9c\xa1\x73\x75\x04\x37\x7a\x87\xb2\x1d\x7a\xf1\x1f\x45\x2c\x1c\x39\x45\x9f\xc6\xa0\xff\x58\xfd\x21\x40\x8b\xae\x72\xd9\x5b\x64\x1c\x4d\x14\x56\xca\x18\xd3\x90\x48\x31\xc6\xb2\x4c\x8a\x94\x51\xf4\x4e\x1e\xc7\xdb\xed\x76\x4c\x32\x8f\x73\x23\x4b\xa3\xf3\xc6\x67\x4a\x7c\x8a\xfc\xc9\x9b\xd9\xd1\x42\x31\xa8\x53\x54\x9e\xcd\xaa\x09\xa6\x95\x3c\x0e\xa3\x05\xe8\x85\x4f\x27\xbe\x69\x29\xbb\x75\x06\x16\xf1\x01\xe6\xcc\x80\x5e\x78\x34\xc2\x59\xd0\x5b\x35\x02\x2b\x28\xc7\xd0\xfe\xaa\x25\x0a\x6d\x33\x59\x57\xdd\x11\x11\x81\xac\xec\xbe\x6d\x2f\x07\x1d\x78\x89\xec\xbc\x89\x1c\x8a\x94\x92\x7c\x02\xcf\x96\xb0\x4a\x68\x82\x21\x06\x9f\x80\xa8\x07\xb7\x0e\x0c\x0d\x5e\x90\x90\x6c\xf1\x97\x1c\xcd\x6e\x86\x12\x53\xa7\x4d\x14\xc6\x9d\xe1\xac\x0b\xe4\x13\xf1\x69\xa8\xfe\x90\xd6\x01\xe4\xb9\xf1\x16\x3c\x9b\xd5\x0c\xba\xdc\x28\x10\xf6\x9d\x50\xc2\x61\xe4\xd5\x10\xd7\x60\x43\x78\x0d\xdd\x2f\xf0\x0a\xec\x3c\xbe\xd6\xb9\x72\xf0\x2d\x3d\xbe\xa7\x93\x8f\x0d\x93\x15\xd1\xa2\x45\x9c\x8a\xe1\x3f\x5d\x9b\x34\x76\x68\x93\x0a\x4d\xa5\x8b\x25\xba\x37\x3a\x57\x5c\xa8\xe5\xb5\x14\xa8\xdc\x67\x4c\x0f\x15\xa0\xc5\xe9\x07\xe6\x56\xf1\x9a\x3d\x46\x17\xa3\xea\x59\xa8\xe8\xfb\x11\x44\x18\xa7\x1e\xf0\x57\x18\x83\x89\x25\x2e\xdc\x10\x26\x60\x62\x7f\xc6\x32\x1c\xc2\xb7\x50\xcb\xd0\xa0\x2d\x2e\x07\x2d\x6e\xfd\x11\x40\xcb\x9c\xa9\x41\xe6\xb0\xb2\x68\x14\xfa\xa3\x8f\x46\xb9\xfe\x2d\x7e\x10\x8a\x43\x02\x61\x5d\xcc\xeb\xd8\x2f\x57\xcb\xc9\x26\x81\xb0\x09\x60\xdb\xdd\x50\x27\xd6\x83\x09\x5f\x1f\xce\x0e\x5e\xfe\x91\x24\x5b\xa1\x2d\x6d\xc5\xb2\x0c\x15\xf7\x69\x2e\xf2\x84\x86\xb5\x9c\xa4\x69\xc6\xf9\xcd\x06\x95\xbb\x13\xd6\xa1\xa2\xc8\x5f\xeb\xdc\xe2\x5a\x6f\x30\x1c\x3d\x65\x2a\xb2\x64\x69\xd2\xa8\xa9\xa1\xf5\x9a\x57\x32\x24\xf0\xfd\xdf\x2e\x5a\x2b\x14\x89\xb3\x46\xb6\x77\xe4\x9d\x91\xef\x29\x46\xd0\x13\x60\x04\x76\x3e\x02\x37\x2a\x4f\xc4\xfa\xd8\xc9\x98\x90\x40\xdb\xc2\x4f\xf8\x8c\xb7\x3d\x8c\x2b\x9e\x26\xf0\x43\x0b\x9b\xa7\x5e\x95\x98\x0a\xeb\x49\x87\x22\xf4\x25\xb1\x7f\x7b\x2c\x15\xb6\x11\x10\xd0\xd0\xd7\xb4\xec\x31\x3c\x42\xfc\x4c\x99\xe9\x56\x8d\x33\x6c\x55\xc3\x20\xb9\xd5\x5c\xea\xf4\xa1\xa6\xd2\xd4\x90\x27\x8c\x28\x91\x75\xad\xd8\x31\xe2\x19\x2a\x34\x74\x7e\x25\x91\x94\x7a\xfe\xf3\x5e\x42\x59\x3b\x6a\x5c\x64\xd8\xc7\x59\x79\xe7\x11\x56\x82\xc8\x33\xce\xdc\x13\xac\x0b\x29\x2b\xce\x1b\x57\xbb\xb8\x80\x6f\xab\xec\xd4\x9a\x2a\x60\xd2\x8a\x73\xb2\xd3\x37\x27\x65\x3b\xd2\x42\x78\xa8\x59\xb9\xc5\xf2\xf8\x08\xec\x56\xb8\x74\x85\x65\xc9\x2a\x67\x98\xba\x47\xbe\xbd\x9b\x41\x79\x40\xd5\x29\x60\x23\x8f\xe1\x01\x31\xa3\xea\x4d\x65\x2b\x93\x2c\xc5\x51\xd9\x1b\xd3\xb6\x0a\x68\xc5\x2c\xcc\x11\x15\x64\x2c\x7d\x60\x4b\xe4\x31\xc0\x47\x25\x77\x1e\xbe\xaa\x6d\x1e\xd8\x1f\xd8\x10\x35\xc5\x9c\xd8\xa0\xdc\x51\x99\x83\xdc\xb6\xb1\x8d\xc0\x96\x5c\x69\xb7\x42\x63\x3d\x0e\xae\xe9\xa8\x80\xd9\x07\xf2\x3a\xea\xca\xa9\x7b\x57\xda\xad\x08\x9f\xb0\x0d\x5d\xbf\xec\x56\xb8\x6e\x97\xca\x46\x05\xd1\x5f\x2a\x92\xd4\xf8\xbe\xa8\x4c\xc4\x14\x9d\x9d\x50\x77\x01\xbf\xff\x0e\x47\x1f\xa3\x4e\xc3\xb1\x51\x3c\xa6\x77\x8c\xd7\x19\x2e\x73\x23\xc3\xa1\x6f\x9b\xc3\xb0\xe3\x16\x65\x4d\xb8\xec\x74\x37\x7f\x70\xa4\xf8\xd7\x4d\x39\x51\xac\xa4\xad\x93\xef\x6f\x56\xab\x97\xff\xcf\xb9\xe2\xc5\x57\xce\x15\x1d\xf1\x5b\x2a\xf8\x03\x33\xc7\x57\xce\x1b\xf1\x4f\x7a\x3e\x3a\x78\x45\x2d\x6a\xab\x95\x3d\x49\xef\xe2\x88\x5e\x5d\x48\x8e\xe2\x76\x04\x19\xcb\x2d\xf2\x66\xad\x7c\xbd\x3c\x06\xaf\xfe\xe7\x51\xef\xeb\xf5\x43\xe5\x6a\x38\xec\x01\x96\x9f\xab\x8a\xfb\xa4\xa4\xbf\x7c\xbe\x3b\x0d\x4b\x67\x26\x90\x74\x1b\xe3\x63\x3f\xed\xc1\x96\x3c\x4a\xcd\x78\x34\x3c\xb9\x74\x9c\x64\x69\x33\xf2\xa6\xa5\x38\x97\x12\xeb\xdf\x91\x26\xa9\x4c\xf7\x48\xd5\x0e\xf1\xa2\x54\xea\x49\x3c\x07\x5c\x94\x74\x8e\xb8\xed\xb9\x17\xdd\xc5\x08\xf6\x94\xd6\x5e\x79\xf7\x6f\xd2\x6b\x7d\x9d\x4d\x12\xad\x73\xfd\x70\x78\x5c\x8f\xc2\x63\x87\x2e\x7a\x83\x46\x3d\x60\x34\x24\x8e\xb5\xf8\xf6\xe3\x87\xaa\xee\xde\x79\x7d\x9e\x2c\x2d\xad\x43\xde\x95\xb4\x37\x8a\x0e\x68\xf9\xd9\x93\xde\x56\x52\xbc\x1c\x9c\x38\x0b\x05\xb2\xdc\xa1\xdf\xe9\xa7\x89\x51\x77\x00\xf1\x32\x0c\x2f\x07\xd3\x49\x7d\xa4\xb3\xdf\x03\x2a\x0e\x45\x31\xf8\xdf\x00\x7c\xfa\x3c\x51\x2b\x1d\x00\x00")
 
 func staticTmplPlyrTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -315,7 +445,47 @@ func staticTmplPlyrTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/tmpl/plyr.tmpl", size: 2618, mode: os.FileMode(420), modTime: time.Unix(1539974850, 0)}
+	info := bindataFileInfo{name: "static/tmpl/plyr.tmpl", size: 7467, mode: os.FileMode(436), modTime: time.Unix(1792404272, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplRulesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6a\xe3\x48\x10\xbd\xfb\x2b\x1e\x1d\x36\xa7\x75\xb4\x39\x84\x85\x58\x12\xec\x61\x6f\x7b\x58\x66\x06\xe6\x38\x94\xd5\x15\xab\x99\x56\xb7\xa8\x2e\xc9\x31\xc2\xff\x3e\xb4\x6c\xc5\x76\x98\x1c\x82\x1a\x24\x4a\xaf\x5e\xd5\xab\x57\xd2\x34\xc1\xf2\x8b\x0b\x0c\xd3\xc4\xa0\x1c\xd4\xe0\x78\x5c\x95\x5b\x41\x51\xaf\x4a\xeb\x46\x34\x9e\x52\xaa\x4c\x43\x62\x0d\x92\x1e\x3c\x57\x66\xef\xac\xb6\xcf\xf8\xfb\xe9\x8f\x0d\x3a\x92\x9d\x0b\xcf\xa0\x41\xe3\xc6\xd4\x2b\x00\x78\x9f\xb8\xb6\x6e\x74\x96\xe5\xfc\x3a\x9f\x6f\xb4\x83\x0c\x9e\xd3\x1c\x29\x0b\xeb\xc6\x0f\x72\x13\x37\xea\x62\xb8\xca\x2d\xfb\xcb\x73\xbe\xbe\x64\x1e\x90\x30\xa8\xef\xbd\x63\x8b\x7d\xcb\x81\x47\x16\x10\x72\xe1\x08\x97\x90\x68\x64\xfb\x00\x7c\x17\xa7\x8c\x18\x78\xae\x8f\x9e\x05\xde\x05\x7e\xbe\xa1\x2c\x9b\x68\xb9\x26\xef\x28\xa1\x42\x43\x21\x06\xd7\x90\x2f\x8b\x39\x0e\xe1\xde\x53\x93\x8b\x42\x69\x87\xbd\xd3\x16\x4e\xd3\x05\x88\x40\x1d\x83\x82\xfd\x0d\x6b\xce\x58\xdf\xef\x74\x03\xd7\xcd\xed\xfe\x89\xa8\x2d\xcb\x42\x4e\xd6\xa6\x4c\x9b\xa0\x2d\x29\x76\x11\xe4\x63\x38\x57\xa1\x30\x63\x1f\x6e\x78\xff\x73\x81\x13\x92\x92\xa8\x5b\x80\x27\x05\x77\x6f\xa4\xc2\x70\xbb\x10\x85\xed\x25\xb7\x2c\xae\x26\x39\x4d\x6b\xb8\x17\x3c\xfc\x2b\x12\xe5\x78\xbc\x80\x6e\x0c\xf1\x3e\x0e\x0a\xf2\x2c\x6a\xea\x69\x5a\xd0\x57\x06\x2e\x5c\x1c\xec\x35\xcb\x4b\x94\x0e\x1d\x6b\x1b\x6d\x65\xfa\x98\xd4\x80\x66\x63\x2b\x53\x64\x23\xd2\x95\xc1\xf9\x94\xca\xaf\x4a\xc2\x34\x8f\xb2\x32\x27\x0c\x24\xee\x53\x65\x1e\x9f\x0c\x66\x07\xda\xe8\x2d\x4b\x65\x3a\xdd\xa1\x42\xc7\x9c\x07\x70\x7f\xf7\xf8\xd7\x26\x29\x05\x3b\xf4\x58\xd7\x4b\x78\xee\x77\xde\x95\xdc\xef\x42\xff\xae\xaa\x0b\xfd\xa0\xd0\x43\xcf\x95\x49\xc3\xb6\x73\x6a\x16\xf1\xdb\x41\x35\x06\x83\x91\xfc\xc0\x95\xf9\x4a\xe3\x69\x85\x92\xc9\x1f\xcb\x1b\x43\x91\x95\xd6\x9f\x10\x5e\xe4\xad\x3d\xbc\x97\xef\x69\xcb\xbe\xbe\xe9\xa7\x69\xb9\xf9\xb9\x8d\xaf\xe6\x3c\x12\x2b\x87\x1f\x32\x5c\x3a\x7a\x34\x98\x21\x6c\x51\xd4\xf8\x5f\x78\x74\xbc\x47\x0c\xfe\x50\x16\x27\xba\x4f\x6a\x45\xe2\x26\x06\x4b\x72\x78\xab\xf1\x4f\xee\xf5\x24\x1b\x1a\xa1\x2d\x63\xdf\x46\xcf\xf0\x6e\x2b\x33\xf0\x83\x59\x9c\x37\x64\xb9\x9d\xfe\x30\xd3\x04\x0e\x16\xc7\xe3\xea\xd7\x00\x88\x2d\x6f\x47\x8a\x04\x00\x00")
+
+func staticTmplRulesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplRulesTmpl,
+		"static/tmpl/rules.tmpl",
+	)
+}
+
+func staticTmplRulesTmpl() (*asset, error) {
+	bytes, err := staticTmplRulesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/rules.tmpl", size: 1162, mode: os.FileMode(420), modTime: time.Unix(1792398127, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplSearchTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\xdb\x8e\xdb\x36\x13\xbe\xf7\x53\x0c\x08\xff\x7b\xf5\x5b\x4a\x0b\x14\x01\xbc\xb4\x82\x20\x49\x81\xa2\x69\x8b\x74\x17\xbd\xa7\xc5\xb1\x4c\x98\xa2\xb4\x24\xe5\xac\x41\xf0\xdd\x0b\xea\x60\xeb\x60\x79\xbb\x0d\x90\xae\x84\x95\x45\xce\xf9\x9b\x19\x8e\x9c\x03\x8e\x3b\xa1\x10\x48\x5a\x28\x8b\xca\x12\xf0\x7e\x41\xb7\x1a\xe2\x64\x41\xb9\x38\x42\x2a\x99\x31\x1b\x92\x32\xcd\x09\x18\x7b\x92\xb8\x21\x5f\x05\xb7\xfb\x35\xbc\xfd\xe9\x7f\xf7\x90\x33\x9d\x09\xb5\x06\x56\xd9\xe2\x9e\x24\x0b\x00\x80\x31\xe3\x8a\x8b\xa3\xe0\xa8\xdb\xed\x70\x3f\x20\xd3\xe9\xbe\x7e\xa5\x31\x17\xc7\x19\x46\x83\xa9\x15\x85\xea\x31\xd2\x5d\xa1\x73\xc8\xd1\xee\x0b\xbe\x21\x19\x5a\x02\xac\xa6\xd9\x90\xd8\xd4\x42\x7b\xc4\x63\x99\x42\x95\x95\x5d\x65\xba\xa8\xca\x11\x55\xb8\x69\xbd\x7d\x85\x76\xb5\x13\x28\x39\x01\x7b\x2a\x71\x43\x2c\x3e\x5b\x02\x8a\xe5\xb8\x21\x4f\x04\x8e\x4c\x56\xb8\x21\xce\x45\x5f\xbc\x27\x13\xa1\xed\x55\x4a\x96\xe2\xbe\x90\x1c\xf5\x86\x58\x96\xad\x85\xb2\xa8\x8f\x02\xbf\x02\x53\x1c\x54\x61\x21\xac\x6a\xf6\x15\x38\xb3\xb8\xfe\xf1\xcd\x0f\x6f\x21\x17\xc6\x08\x95\xad\x39\x9a\x54\x8b\x32\x78\x49\x02\x30\x67\xa9\xb7\x5d\x5c\x6d\x2b\x6b\x07\xc1\xbb\xe2\x6d\xe3\x93\xa9\xb6\xb9\xb0\xa4\x13\xd2\x32\x76\xbe\x35\x60\x5d\x57\x7d\x01\xef\xc6\x92\x73\x2b\x58\x1e\x60\xbd\x81\xe8\x57\xa1\xb8\xf1\x7e\xc8\x21\xd9\x16\x65\xa7\xbc\x41\x71\x75\x08\x84\x24\xf9\x43\xc9\xd3\x7a\x71\xd3\xf8\x74\x8f\xe9\x61\x5b\x3c\x77\xa0\x04\xce\x33\x2e\x21\xf1\x0a\x02\xce\x69\xa6\x32\x84\xe5\xc1\x7b\xe7\xc4\x0e\xf0\x09\x22\x68\x77\xbd\xaf\x45\x20\x77\x0e\x15\x0f\x04\xf5\x03\xe2\x04\xfe\x0a\x04\xdf\xa0\x9e\x55\x5c\xcc\xab\x6f\x76\x6f\xa8\x7f\x1f\x08\xbe\x41\xbd\xc8\x59\x86\xb3\xea\x9b\xdd\x1b\xea\x7f\x09\x04\x03\xf5\x34\xae\xb1\xba\xc0\x4b\xe3\x50\x90\x97\xf7\x00\xb5\xd8\x41\xf4\x49\xeb\x42\xf7\x70\xee\xe7\x68\xca\xa4\x2c\x2a\x0b\x4c\xa2\xb6\x24\x71\xae\xa3\x1e\xa5\x4e\x90\x85\xd2\xa0\xf7\x13\xf1\x0f\x55\x96\xa1\x09\x15\xd1\xd7\x51\x26\x1f\x05\x87\x53\x51\x41\x8e\x4c\x01\x65\xb0\xd7\xb8\x3b\x77\x86\x77\x4f\x1b\xe7\x06\xac\x24\xa1\x98\x27\xa3\x45\x1a\x63\x9e\xd0\x98\x25\xef\x68\x5c\x8e\xac\x51\x7c\xa8\xcf\xb9\xe8\xb1\xb0\x4c\x7a\x0f\x39\xb3\xe9\x5e\xa8\x0c\x50\x59\x2d\xd0\x0c\x98\x69\x21\x2f\x2f\x9d\xb4\x16\x14\xf1\x7f\x58\xea\xba\x38\xfe\x44\x53\x49\x3b\x2d\x0f\x31\xe4\x0d\xd7\xc5\xb7\x52\xb2\x13\xea\x77\x3b\x21\x71\xe3\xdc\x52\x47\x9f\x94\xd5\xa7\xe8\x67\x21\x31\x64\x44\x70\xd2\x39\x8b\x79\x29\x99\x45\x20\x3b\xcd\xb2\x1c\x95\x35\x04\x96\x3a\x7a\x14\x56\x62\x70\x99\x4d\x55\xb4\xb1\xc6\x27\x38\x0b\x0d\xd5\xdb\xa5\xed\x25\x7f\xa6\xc6\x99\x92\xa9\x0e\xed\x3a\x61\xc0\x60\x5a\x28\xce\xf4\x89\x24\x3d\x23\x83\xbc\xa0\x3d\xd0\x5f\x37\x60\x18\xf1\x91\x69\x4b\x1d\x7d\xbc\xf4\xc6\x6b\x86\xd4\x27\x19\x35\x39\x93\xf2\x46\x14\x06\x42\x68\xdc\x90\xbf\xd2\x9c\x16\x4c\x1d\x7d\xa8\xd0\xbc\x64\xca\x2b\xd0\xbb\x63\x79\x79\x6f\xeb\xc4\xb5\x4c\xdb\x16\x4e\x91\x63\x5a\x70\x84\x6e\x31\x00\x08\x77\x92\x3f\x55\xc5\xfd\x8c\x9f\xd1\x23\x3e\x5b\xef\xef\x74\x4d\xf4\xad\x5e\xbe\x57\xaa\xb0\x2c\x84\xfd\xfb\x3b\x7b\xa7\x38\x33\xfb\xfb\xfe\xce\xa7\x60\x71\x1d\x04\xe7\xa2\xcf\x21\xe3\x42\x23\x6b\x30\x89\x1e\x59\x66\xbc\x7f\x31\x2b\xa3\x73\x26\xb6\x2d\xf0\x95\x31\xa2\xf1\xb8\x50\xa7\x74\x34\xee\x37\x82\x36\x8d\xa3\xdf\xf1\xd9\x7e\x16\xea\xd0\x27\x64\x9d\xa5\xcd\x61\xdc\x33\xb5\x8d\xa6\x73\x3d\x3e\x92\x84\xdf\x50\xb2\x0c\x07\xb5\x3c\xb5\xa0\xd3\xf9\xc5\xfb\x99\xa1\xaa\x2c\xcc\x74\xaa\x42\x13\x1b\x76\xc4\xd1\x30\x31\x38\x89\xf6\x82\x73\x54\xb3\xa3\xd1\x78\x7c\x98\x99\x5a\x48\x72\xbb\x9d\xf4\x07\x9c\x1a\x44\x92\x3c\xb0\x23\x82\xdd\x0b\x03\x8d\xad\xc0\xcc\x5c\x4b\x69\x0d\x7e\xcd\x8c\x17\xfe\xff\x37\x63\x17\x3b\xce\x28\x7e\x71\xe8\xba\x76\x2a\x4f\xd3\xe0\xb2\xd2\xb2\x77\x8f\xef\x30\xff\xb3\x23\xf2\x16\x2e\x34\xff\xe6\x3b\xc0\xb2\xad\xc4\xcb\x7b\xe7\x53\x53\xf2\x21\x55\xf9\x43\x2b\xbd\xe7\x75\xb8\xa9\xd5\x43\xb6\x70\x51\xcb\x2f\x7d\x2a\xb4\x90\xb6\xaa\x42\x91\xd5\x6d\x38\x54\x15\x8d\x2d\x9f\x61\x0d\xcd\x38\xb4\x90\x2f\x15\xea\x53\xe8\x23\xf5\xc2\x3c\x43\x3d\x85\x49\x0b\xd1\x87\xa2\x52\x16\xde\x78\x2f\xd4\x91\x49\x11\x86\xb0\x7a\xe2\x71\xae\xd9\x3a\xcf\x63\xf3\xb2\x26\x8b\xff\xbc\xa4\x39\x4a\xb4\x48\xae\x8b\x78\xa1\xc0\x9b\xba\xb8\xd4\x78\x13\xa7\xab\x09\x7b\x55\xda\xd5\xe4\x07\x2b\xd4\xa9\x1d\x0c\x3b\xd9\x1f\x1b\x23\xe7\x04\x8f\x73\xbd\xfb\x9b\x06\x8c\xc6\x63\xe8\xa7\x75\x41\xe3\x5e\x62\x5d\x2d\x0b\xe7\x42\xef\x0f\xdf\xc9\x8b\xde\xf7\x73\xef\xa0\xed\x9d\x3c\xed\xb8\x1d\xfd\x16\x66\x43\xef\x69\xce\xf4\x21\xa4\x49\x73\x14\xd3\xb8\x7d\x3f\x43\xde\xac\xb7\x88\x9f\x1f\x80\x8a\x83\xf7\x8b\xbf\x07\x00\x23\x23\x79\x3f\xaf\x0f\x00\x00")
+
+func staticTmplSearchTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplSearchTmpl,
+		"static/tmpl/search.tmpl",
+	)
+}
+
+func staticTmplSearchTmpl() (*asset, error) {
+	bytes, err := staticTmplSearchTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/search.tmpl", size: 4015, mode: os.FileMode(420), modTime: time.Unix(1792400527, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -340,6 +510,46 @@ func staticTmplStatusTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _staticTmplTagsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\x5f\x6f\xdb\x38\x12\x7f\xcf\xa7\x18\x10\xe9\xe1\x0e\xa8\xad\xb4\x68\xef\x80\x44\x76\xd1\xa6\x08\xae\xb8\xf4\x0f\x72\xe9\x3e\xec\xcb\x82\x16\x47\x12\x51\x8a\x54\x29\xca\x89\x21\xf0\xbb\x2f\x48\x4b\xb6\x24\xd3\x89\x9b\x26\xdd\x87\x55\x84\x18\xe2\xfc\xe1\x70\x7e\x33\x24\x67\x9a\x06\x18\xa6\x5c\x22\x90\x44\x49\x83\xd2\x10\xb0\xf6\x28\x5e\x68\x88\xe6\x47\x4d\x33\x81\x1b\x6e\x72\x98\x5e\x61\x55\x0b\xe3\x28\x8c\x2f\x21\x11\xb4\xaa\x66\x24\xa1\x9a\x11\xa8\xcc\x4a\xe0\x8c\xdc\x70\x66\xf2\x53\xf8\xcf\xeb\x67\x67\x50\x50\x9d\x71\x79\x0a\xb4\x36\xea\x8c\xcc\x8f\x00\x00\xc6\x82\x13\xc6\x97\x9c\xa1\x6e\xc9\xee\x6d\x1a\x9e\xc2\xf4\xbd\x5e\x5d\xd5\xd2\xda\x2f\x1a\x97\x1c\x6f\x40\xa5\x4d\x83\xa2\x42\x70\xc4\xcf\xe5\xf4\xab\x64\x4a\xa2\xb5\x5f\x25\xe3\x6c\x4d\xb2\xf6\x6d\x59\x0a\x8e\xee\x53\x32\x6b\x7b\x1a\xa7\x9f\xcb\xe9\xff\xb8\x1b\x5c\x6b\xff\x5c\x4e\x2f\xb4\x2a\xac\x05\x95\x42\xcb\xde\x34\x9a\xca\x0c\xe1\x98\x3f\x87\xe3\x14\x4e\x67\x3d\x36\x2f\x75\xcc\xad\x7d\xde\x71\xc7\x89\x62\x38\x6f\x9a\x52\x73\x69\x52\x20\xcf\xbe\x13\x38\x4e\xad\x8d\xa3\x96\x30\x36\x61\xd2\x59\x7e\xad\xac\x05\xa3\x20\xa4\xa1\xa3\x0f\xb5\x9c\xf6\xd4\x08\x94\xde\xae\xf3\xdc\x19\x5b\x59\x0b\xce\x7f\xaa\xf2\x2c\x71\xc4\xf8\x72\x8f\xa3\x2b\x4c\x0c\x57\xb2\xe7\xe8\xd8\xd0\x85\xc0\xed\x77\x67\xe7\xda\x0d\x83\x49\x06\x3c\xb1\xd1\x43\x21\xf7\x17\x1b\x36\x8f\x29\xe4\x1a\xd3\x19\x89\x4a\x41\x57\xa8\xdf\xa4\x5c\xe0\xac\x69\xa6\x17\x5c\xa0\xa4\x05\x5a\x4b\xe6\x83\xcf\x38\xa2\xf3\x38\x32\x2c\xac\x6f\x80\x88\xf1\x88\xbc\xc3\x54\x69\x0c\x01\xd2\x34\xc7\xc6\xfd\xf7\x7e\xdf\xaf\xf3\x1f\x9a\x6a\x7d\xf6\x43\x73\xbe\x4d\x0d\xea\x07\x4c\x19\x47\x63\x47\x39\xef\x0e\xe3\x22\x8e\x46\x20\x0c\x01\xf8\xff\x37\x5e\x96\x38\x10\x18\xe0\x2a\x84\xaa\x0d\x50\x81\xda\xf4\x80\x75\xef\x25\xa6\x06\x1e\x06\x08\x50\xa1\x24\x9e\xba\xb4\xb9\x42\x5a\x29\x39\xb0\x77\x13\x61\xe1\x05\x75\x81\x7e\x98\xe5\x37\x54\x4b\x2e\xb3\x90\xed\xde\x08\x58\x60\x42\xeb\x0a\xc1\xe4\xc8\x35\x18\x9a\x55\x90\xf8\xc8\x67\x50\x71\x99\xe0\x36\x35\xdc\xdf\x00\xbc\x75\x0a\x6f\xec\x08\xc2\x97\x6e\xe0\x3b\x6c\x85\x2d\xb1\xfb\xd9\xee\x8f\x6b\x96\xa7\xdc\x16\xdf\xd5\xe2\x5b\xbb\xf6\x07\x25\x7b\x8f\x25\xd3\x9c\x4d\x6e\xc1\xff\x94\x94\x31\x2e\xb3\xc9\x6d\x8f\xd7\xbd\x71\xaa\x74\xd1\x09\x14\xc8\x78\x5d\x4c\x5e\x41\x82\x42\x10\x28\xd0\xe4\x8a\xcd\x48\xa9\x2a\x43\x80\xfa\xa9\x66\x24\x72\xe8\x44\xda\xc7\xd5\x48\x99\x7b\x63\x41\x17\x28\xe6\x57\x9e\xee\x90\x1c\x42\xd7\x3d\x31\x97\x65\x6d\xc0\xac\x4a\x9c\x11\x83\xb7\x86\x80\x13\x98\x91\x54\xab\x82\x38\x77\x77\x9c\xdd\x13\x47\x6b\xcd\xbb\x84\xf5\xf8\xb5\xfa\xc1\x99\x8c\x7a\xd0\x3c\x03\x7d\x49\x8e\xc9\xb7\x85\xba\xed\x74\x32\xbd\xfa\x43\xd7\x92\xc0\x92\x8a\x1a\x67\xe4\x05\x01\xcf\x82\x0c\xa2\x39\x6c\x0e\x39\x29\x56\xfb\xa7\xe9\xeb\xaf\xea\x45\xc1\x0d\xe9\x00\x5a\xd4\xc6\xa8\xad\xf6\xb5\x93\x77\x56\x11\x47\x0e\xd4\xc7\xc1\xb9\x40\x9d\xdd\x01\xf3\x47\x47\x76\x28\x57\xf0\xcf\x44\x15\x05\x85\x0a\x4b\xaa\xa9\x41\xf6\xaf\x5f\x05\xfc\x07\x69\xfe\x7e\xd0\x7b\xc7\x3f\x29\xf2\x0c\x05\x9a\x3b\xa0\x7f\xef\xe9\xbf\x32\xc3\xff\x7a\xf7\xb7\x47\x70\x37\xc7\xda\x05\xf7\xa2\xd0\xdf\xc1\x43\x07\xcb\x53\x1e\x27\xd7\x34\x83\x9c\xa3\xa6\x3a\xc9\x57\x0f\x38\x4f\x9a\xc6\x60\x51\x0a\x6a\x10\x88\xa1\x99\xd1\x88\x04\xa6\xd7\x1a\xb1\x7f\x96\xfa\x10\xbb\x73\x1b\x51\xcb\x71\x28\xf5\x27\xf7\xc0\x4e\x32\xad\xea\x72\xc4\xe5\xde\xb8\x2a\xa9\x0c\xb0\x4e\x7c\x58\x90\xf9\x47\xb5\xc4\x38\x72\x4c\x01\x59\xcf\x1f\x12\x4e\x39\x0a\x46\xf6\xc6\x67\x29\x68\x82\xb9\x12\x0c\xf5\x8c\x08\x95\x50\xb7\x1c\x17\x39\xe1\xd0\xbd\xc7\x44\x2a\x19\xe0\x12\xf5\xca\xe4\x5c\x66\xb0\x40\xa1\x6e\x80\x1b\x30\xea\x11\x0d\x37\x6a\x64\xb6\xff\x78\xb0\xcd\x8f\x96\x6e\x7b\x16\x18\xc6\x7f\xd2\x6e\x74\xbb\x02\x87\xa5\xe8\xc6\x22\x17\x15\xe1\x75\x6f\x13\x60\xcf\x50\x3f\x81\x5b\xd2\x2f\xcd\xd8\x0a\xb8\x84\xba\xf2\x77\x73\x5f\x08\xba\xb1\xe1\xd5\xf4\xc0\xf4\x0d\xd5\x7e\xb1\xc9\x91\x06\xcb\xa2\x40\xbd\xd7\x0a\xcc\xaf\x69\x16\x47\x26\xdf\xcf\xf0\x9b\x2f\x4f\xef\xe6\xb9\xe0\xba\x32\x6e\x61\xec\x6e\xbe\x4b\x7a\x10\xdb\x17\x55\x55\x7c\x21\x10\x58\x5d\x0a\x9e\x50\x83\x55\x58\x60\xb7\x42\x8b\xa3\x80\x13\x62\xb3\x50\x6c\x35\x1c\x1b\xd5\x6a\x3d\x20\x0e\x74\x5d\xbf\x5c\xae\xd0\x6d\xc3\x6f\xbe\xcf\xdc\xa1\x39\xea\x08\x7c\x6a\xcb\x34\xdf\x2e\x80\xa6\xf1\x9d\x18\x43\xb3\x73\x25\x94\xee\xc8\x6d\x94\x25\x6e\xcc\x05\xc7\xd4\xda\x33\xd2\x56\x36\xe3\x16\xc3\xa7\xb6\xd0\x73\xfa\xe6\xfb\x0b\xf0\x6d\x41\x3c\x3d\x57\xb5\x34\xd6\xde\xc7\xc7\x53\x90\xca\xc0\xd4\xa3\x39\xfd\x50\xfd\x8e\x5a\xb9\xfa\xaa\x1d\xb8\x50\xba\xa0\x06\xc8\xcb\x93\x93\x7f\x4f\x4e\x5e\x4c\x4e\x5e\x92\x3b\x8a\xe7\xb0\xea\x4b\x3a\xd4\x7c\x49\x7f\x52\x71\xaf\x5e\xac\xd6\xf5\x22\x2f\xb8\xa0\xfa\xe0\x96\x4f\x35\x6e\xd6\x84\x67\xdc\x8d\xb4\xdd\xe2\xb2\x7b\xe2\x68\x14\x6f\x83\x26\x41\x70\xdf\xe9\xaa\xee\xff\xf2\xca\x28\xbd\x7a\xe2\x52\xb4\x9d\xe5\x91\xb6\x9d\x5e\x16\x6d\xcd\xbf\x37\x89\xda\xd0\x6c\x3b\x7e\x81\x18\x80\x17\xaf\x4f\x4f\x5e\x91\xbd\x80\xac\xe5\xfb\x4d\xc1\xb6\x23\xd8\xe2\x18\xe8\x25\xfc\x5c\x2f\xd0\xcb\xf9\xee\x1e\xac\x9b\x50\xe1\x0e\xe0\xb5\x3a\x30\xa2\xfc\x02\xfc\x29\x30\xee\x05\xee\x17\xd8\x19\xec\x00\x70\xa6\x75\x2d\xd5\x20\x53\xed\x89\x7b\xe5\xd7\xfd\xd7\x20\xf9\xfe\x4b\xa0\xd3\xdd\x0b\x92\x3b\xcf\xf6\x9c\x33\x86\xb2\xbb\x6d\x70\xb6\x39\xd6\x9b\x66\xfa\xe1\xbd\xb5\xc1\x93\x3d\xa8\x29\x7c\x91\x37\x5c\xae\xa0\xc2\x44\x49\x46\xf5\x6a\xa3\xdd\xf9\x66\xaf\xea\xf1\xbd\xfe\xfe\x14\x6f\xb3\x7a\x04\xc8\xee\x26\x71\x4f\xc3\x70\xef\x5e\xb0\x96\x69\x1a\x40\xc9\x5c\x27\xff\xa8\xd7\xe1\xdf\xdc\xd6\xdd\x2e\x51\xb7\xe5\x4e\x2f\x09\xdb\xc9\x62\xc1\xb7\xb6\x1c\x76\x54\x7d\xa1\x26\x6f\x5b\xbc\xdd\x21\x43\xe7\x47\xc1\x6b\xe5\x82\xb2\x0c\xfb\x7e\x36\xdc\xb8\xdd\x69\x7b\xda\xb8\x02\x32\x43\x06\x78\x4b\x13\x23\x56\x5e\xeb\xb5\x32\x54\x58\x3b\xbe\x39\x76\x01\x7c\x9e\x73\xc1\x34\x4a\x6b\xc3\xd5\x49\x9f\xbe\xf5\x6a\x1c\x75\x2b\xdd\x7a\x2e\x8e\x9c\x63\x9a\x06\x50\x32\xb0\xf6\xe8\xcf\x01\x00\xda\x4d\xc7\xcc\x21\x19\x00\x00")
+
+func staticTmplTagsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplTagsTmpl,
+		"static/tmpl/tags.tmpl",
+	)
+}
+
+func staticTmplTagsTmpl() (*asset, error) {
+	bytes, err := staticTmplTagsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/tags.tmpl", size: 6433, mode: os.FileMode(420), modTime: time.Unix(1792404012, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticTmplVocabTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\xdb\x6a\x1b\x31\x10\x7d\xf7\x57\x0c\xc2\x79\xb3\x2d\x27\x90\x16\x1c\xed\x96\x50\x08\x7d\x29\x2d\x69\x7f\x40\x5e\xcd\xda\x6a\x65\x69\x23\x69\xed\x1a\xa1\x7f\x2f\xda\x8b\xe3\x6b\x48\xf2\x50\xa8\x25\x30\x3b\xb3\x73\xce\xec\xe8\x1c\x85\x00\x02\x4b\xa9\x11\x48\x61\xb4\x47\xed\x09\xc4\x38\x60\x73\x0b\x34\x1f\x30\x21\xd7\x50\x28\xee\x5c\x46\x0a\x6e\x05\x01\xe7\xb7\x0a\x33\xb2\x91\xc2\x2f\x67\xf0\xf1\xf6\xea\x0e\x56\xdc\x2e\xa4\x9e\x01\xaf\xbd\xb9\x23\xf9\x00\x00\xe0\xb8\x70\x2c\xe4\x5a\x0a\xb4\x5d\x3a\xed\xcf\x46\x7b\x6b\x94\x42\x01\x6b\x53\xf0\x79\xad\xb8\xdd\x36\x59\x46\x85\x5c\x5f\xc0\x71\x58\x78\x69\xf4\x1e\x0e\x2b\x8d\x5d\xc1\x0a\xfd\xd2\x88\x8c\x54\xc6\x79\x02\xbc\x79\x29\x23\xb4\x41\xde\x7b\x39\x6d\xa6\xf8\x1c\x55\xfe\xd5\x08\x9c\x1d\x24\xd2\x66\x0e\x15\x16\x1e\x34\x5f\x61\x46\x56\x46\xe0\x51\x75\xbf\x98\xa9\x12\x07\xac\xb9\xaa\x31\x23\xa6\x2c\x09\x84\x20\x4b\xc0\x27\x98\x24\x6c\x68\x62\x31\xb6\x80\x28\x42\x40\x2d\x62\xcc\xbf\x95\xe5\x08\xb8\xde\x82\xe7\x0b\x90\x0e\x78\x51\x60\xe5\x51\x30\xda\x22\xbe\x8a\xce\xe2\x2f\x2c\xfc\x09\x63\x17\x3e\x25\x7d\x6c\x12\x50\x57\x82\x7b\x74\xb0\x91\x7e\x09\xb5\xfe\xad\xcd\x46\xa7\x3e\xdc\x9b\xc8\x2b\x6b\x2a\xe3\xf0\x84\xbd\x8f\x9f\xd2\x7f\x31\x4a\x1c\xf0\x41\x69\x2c\xf0\xaa\xb2\x66\xcd\xd5\x65\x72\x46\x5b\xa4\xc3\x0c\xa3\xed\x09\x1e\x06\xdb\xd8\x7d\x37\xcd\x86\x65\x04\x46\x23\x54\x68\x41\x49\x7d\xee\xb0\x3d\xfe\xf1\xdc\x22\xef\x8e\x3b\xd5\x10\xb0\x66\xe3\x32\x72\x7d\x4b\xf2\x10\x2c\xd7\x0b\x84\xc9\x4f\xbe\x70\x31\x86\x30\x89\x71\xd0\x7d\x13\xa3\x7d\xf1\x6b\x9a\x93\xba\xaa\x3d\xf8\x6d\x85\x19\x71\xf5\x7c\x25\x3d\xe9\x65\x3d\xaf\xbd\x37\x9a\xf4\xc3\xfd\xc1\xd7\xb8\x67\x08\x92\x6c\xb8\x83\xa1\x49\xeb\x9d\x33\x5a\x93\x74\x5e\xf9\x07\x7e\xfd\xde\x9e\x6e\x3b\xd9\x19\x84\xa0\x50\xc3\xa4\x8f\xc6\xf8\x1e\xeb\x7a\x3e\x57\xf8\xfc\x9c\x56\x08\x63\xe8\xa6\x7e\x84\xdd\x2f\xe6\xed\x61\x49\x5a\xcc\x8b\x9c\x15\x46\x60\x1e\x42\x65\xa5\xf6\x25\x90\xab\x27\xd2\x1c\x5d\x8c\x8c\x36\x29\x46\xbd\x38\x5f\x1a\xc2\xee\x4b\x26\x0f\xc6\xae\xb8\x07\x72\x33\x9d\x7e\x18\x4f\xaf\xc7\xd3\x1b\x12\xe3\x4b\xa5\x6d\xbb\x43\x39\x82\x61\x09\xb3\x0c\x26\x0f\x52\x61\xa3\x17\x59\xc2\x50\xc6\x38\x82\x5e\x34\x1c\x96\x16\xcb\x8c\xd0\x4a\xf1\x2d\xda\x4f\xa5\x54\x98\x85\x30\x2c\x63\x4c\x72\x4b\xff\x8c\xf2\xfc\x59\x63\x17\x48\x4f\x82\xaf\xbb\x09\x69\x6b\x39\xdc\xe9\x42\x48\x97\x3a\x99\x81\xd4\xc9\x22\xbd\x1c\xce\xfd\x0e\x34\xbc\x94\x42\xa0\x26\xcf\xbe\xd9\xe9\x37\x84\x76\xe4\x07\xc2\x7d\x11\xeb\xac\x1f\xc0\x4b\xbd\x05\x57\x17\x05\x3a\xb7\x03\xbf\xef\xdb\xbf\x80\xbd\x6f\x90\x77\x4c\xa7\xbf\x55\xff\x8f\xe1\x70\x85\xd6\xef\xa0\x1f\xbb\xde\xdf\x38\x99\x53\x89\x31\x7a\xec\xaf\x64\xc9\x46\x8f\xbb\x28\xa3\x7b\xce\x3d\x7b\x13\x85\x00\xa8\x05\xc4\x38\xf8\x3b\x00\x48\xb2\x2e\x67\x62\x08\x00\x00")
+
+func staticTmplVocabTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_staticTmplVocabTmpl,
+		"static/tmpl/vocab.tmpl",
+	)
+}
+
+func staticTmplVocabTmpl() (*asset, error) {
+	bytes, err := staticTmplVocabTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/tmpl/vocab.tmpl", size: 2146, mode: os.FileMode(420), modTime: time.Unix(1792398184, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -401,10 +611,20 @@ var _bindata = map[string]func() (*asset, error){
 	"static/js/vendor/foundation.min.js": staticJsVendorFoundationMinJs,
 	"static/js/vendor/jquery.js": staticJsVendorJqueryJs,
 	"static/js/vendor/what-input.js": staticJsVendorWhatInputJs,
+	"static/tmpl/audio.tmpl": staticTmplAudioTmpl,
+	"static/tmpl/entry.tmpl": staticTmplEntryTmpl,
+	"static/tmpl/fields.tmpl": staticTmplFieldsTmpl,
+	"static/tmpl/image.tmpl": staticTmplImageTmpl,
+	"static/tmpl/jobs.tmpl": staticTmplJobsTmpl,
 	"static/tmpl/list.tmpl": staticTmplListTmpl,
 	"static/tmpl/main.tmpl": staticTmplMainTmpl,
+	"static/tmpl/namespaces.tmpl": staticTmplNamespacesTmpl,
 	"static/tmpl/plyr.tmpl": staticTmplPlyrTmpl,
+	"static/tmpl/rules.tmpl": staticTmplRulesTmpl,
+	"static/tmpl/search.tmpl": staticTmplSearchTmpl,
 	"static/tmpl/status.tmpl": staticTmplStatusTmpl,
+	"static/tmpl/tags.tmpl": staticTmplTagsTmpl,
+	"static/tmpl/vocab.tmpl": staticTmplVocabTmpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -464,10 +684,20 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			}},
 		}},
 		"tmpl": &bintree{nil, map[string]*bintree{
+			"audio.tmpl": &bintree{staticTmplAudioTmpl, map[string]*bintree{}},
+			"entry.tmpl": &bintree{staticTmplEntryTmpl, map[string]*bintree{}},
+			"fields.tmpl": &bintree{staticTmplFieldsTmpl, map[string]*bintree{}},
+			"image.tmpl": &bintree{staticTmplImageTmpl, map[string]*bintree{}},
+			"jobs.tmpl": &bintree{staticTmplJobsTmpl, map[string]*bintree{}},
 			"list.tmpl": &bintree{staticTmplListTmpl, map[string]*bintree{}},
 			"main.tmpl": &bintree{staticTmplMainTmpl, map[string]*bintree{}},
+			"namespaces.tmpl": &bintree{staticTmplNamespacesTmpl, map[string]*bintree{}},
 			"plyr.tmpl": &bintree{staticTmplPlyrTmpl, map[string]*bintree{}},
+			"rules.tmpl": &bintree{staticTmplRulesTmpl, map[string]*bintree{}},
+			"search.tmpl": &bintree{staticTmplSearchTmpl, map[string]*bintree{}},
 			"status.tmpl": &bintree{staticTmplStatusTmpl, map[string]*bintree{}},
+			"tags.tmpl": &bintree{staticTmplTagsTmpl, map[string]*bintree{}},
+			"vocab.tmpl": &bintree{staticTmplVocabTmpl, map[string]*bintree{}},
 		}},
 	}},
 }}
@@ -0,0 +1,352 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Chapter marks the start of a section of a video.  A chapter runs
+// until the next one starts.
+type Chapter struct {
+	Start float64
+	Title string
+}
+
+// lastChapterLength is how long the last chapter is taken to run in
+// the WebVTT track when the length of the video isn't known.
+const lastChapterLength = 24 * 60 * 60
+
+// parseChapterList reads chapters written one per line as a time
+// followed by the title, such as "03:12 Alice speaks".  A dash or
+// colon between the time and the title is allowed, and blank lines
+// are ignored.
+func parseChapterList(text string) ([]Chapter, error) {
+	var out []Chapter
+	sc := bufio.NewScanner(strings.NewReader(text))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		i := strings.IndexAny(line, " \t")
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: chapters are written as 00:00 Title", n)
+		}
+		start, err := parseTimecode(line[:i])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", n, err)
+		}
+		title := strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line[i:]), "-–:"))
+		if title == "" {
+			return nil, fmt.Errorf("line %d: chapter has no title", n)
+		}
+		out = append(out, Chapter{Start: start, Title: title})
+	}
+	sortChapters(out)
+	return out, nil
+}
+
+func sortChapters(c []Chapter) {
+	sort.SliceStable(c, func(i, j int) bool { return c[i].Start < c[j].Start })
+}
+
+// vttTimestamp writes seconds the way WebVTT cue timings are written.
+func vttTimestamp(sec float64) string {
+	ms := int64(sec*1000 + 0.5)
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+// vttTitle keeps a chapter title to the single line of its cue.  A
+// line break would end the cue and an arrow would start a new one.
+var vttTitle = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ", "-->", "->")
+
+// writeChapterVTT writes chapters as a WebVTT chapter track.  The last
+// chapter ends with the video, which lasts duration seconds, or zero
+// if that isn't known.
+func writeChapterVTT(w io.Writer, chapters []Chapter, duration float64) error {
+	b := bufio.NewWriter(w)
+	b.WriteString("WEBVTT\n")
+	for i, c := range chapters {
+		end := duration
+		if i+1 < len(chapters) {
+			end = chapters[i+1].Start
+		} else if end <= c.Start {
+			end = c.Start + lastChapterLength
+		}
+		fmt.Fprintf(b, "\n%d\n%s --> %s\n%s\n", i+1, vttTimestamp(c.Start), vttTimestamp(end),
+			vttTitle.Replace(c.Title))
+	}
+	return b.Flush()
+}
+
+// mp4Chapters reads the Nero style chapter list kept in the
+// moov/udta/chpl box of MP4 files.
+func mp4Chapters(r io.ReadSeeker) ([]Chapter, error) {
+	box, err := mp4Find(r, "moov", "udta", "chpl")
+	if err != nil || box == nil {
+		return nil, err
+	}
+	data, err := mp4Read(r, box, ebmlMaxElementSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// version, flags, a reserved word in version 1 and a count
+	p := 4
+	if len(data) > 0 && data[0] == 1 {
+		p += 4
+	}
+	if p >= len(data) {
+		return nil, fmt.Errorf("mp4: short chpl box")
+	}
+	count := int(data[p])
+	p++
+
+	var out []Chapter
+	for i := 0; i < count; i++ {
+		if p+9 > len(data) {
+			return nil, fmt.Errorf("mp4: short chpl box")
+		}
+		start := binary.BigEndian.Uint64(data[p:])
+		n := int(data[p+8])
+		p += 9
+		if p+n > len(data) {
+			return nil, fmt.Errorf("mp4: short chpl box")
+		}
+		// Chapter times are in units of 100ns.
+		out = append(out, Chapter{Start: float64(start) / 1e7, Title: string(data[p : p+n])})
+		p += n
+	}
+	sortChapters(out)
+	return out, nil
+}
+
+// mkvChapters reads the chapters of the first edition of a Matroska
+// file.  Hidden chapters are skipped and nested chapters flattened.
+func mkvChapters(r io.ReadSeeker) ([]Chapter, error) {
+	var data []byte
+	err := mkvTopLevel(r, func(el ebmlElement) (bool, error) {
+		if el.ID != mkvChaptersID {
+			return true, nil
+		}
+		var err error
+		data, err = mkvRead(r, el, ebmlMaxElementSize)
+		return false, err
+	})
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	editions, err := ebmlChildren(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, ed := range editions {
+		if ed.ID != mkvEditionEntry {
+			continue
+		}
+		var out []Chapter
+		if err := mkvChapterAtoms(data[ed.Start:ed.Start+ed.Size], &out); err != nil {
+			return nil, err
+		}
+		sortChapters(out)
+		return out, nil
+	}
+	return nil, nil
+}
+
+func mkvChapterAtoms(data []byte, out *[]Chapter) error {
+	els, err := ebmlChildren(data)
+	if err != nil {
+		return err
+	}
+	for _, el := range els {
+		if el.ID != mkvChapterAtom {
+			continue
+		}
+		atom := data[el.Start : el.Start+el.Size]
+		fields, err := ebmlChildren(atom)
+		if err != nil {
+			return err
+		}
+
+		var c Chapter
+		hidden := false
+		for _, f := range fields {
+			payload := atom[f.Start : f.Start+f.Size]
+			switch f.ID {
+			case mkvChapterStart:
+				// Chapter times are in nanoseconds.
+				c.Start = float64(ebmlUint(payload)) / 1e9
+			case mkvChapterHidden:
+				hidden = ebmlUint(payload) != 0
+			case mkvChapterDisplay:
+				if c.Title != "" {
+					continue
+				}
+				display, err := ebmlChildren(payload)
+				if err != nil {
+					return err
+				}
+				for _, d := range display {
+					if d.ID == mkvChapterString {
+						c.Title = string(payload[d.Start : d.Start+d.Size])
+						break
+					}
+				}
+			}
+		}
+		if !hidden {
+			*out = append(*out, c)
+		}
+		if err := mkvChapterAtoms(atom, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importChapters reads the chapters embedded in a video file.
+func importChapters(file string) ([]Chapter, error) {
+	f, err := os.Open(filepath.Join(*videoDir, file))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var chapters []Chapter
+	switch ext := strings.ToLower(filepath.Ext(file)); ext {
+	case ".mp4", ".m4v", ".mov":
+		chapters, err = mp4Chapters(f)
+	case ".mkv", ".webm":
+		chapters, err = mkvChapters(f)
+	default:
+		return nil, fmt.Errorf("can't read chapters from %s files", ext)
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = fmt.Errorf("%s is truncated or not a %s file", file, filepath.Ext(file))
+	}
+	return chapters, err
+}
+
+// setChapters replaces the chapters of an entry.  The caller must
+// hold libraryLock for writing.
+func setChapters(file string, chapters []Chapter) {
+	e := *library[file]
+	e.Chapters = chapters
+	library[file] = &e
+	dbDirty = true
+	libraryVersion++
+}
+
+// chaptersHandler serves the chapters of a file, as JSON or with
+// format=vtt as a WebVTT chapter track.  Posting a chapter list
+// replaces the chapters.
+func chaptersHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	file := r.FormValue("file")
+
+	var chapters []Chapter
+	var known bool
+	if r.Method == http.MethodPost {
+		var err error
+		chapters, err = parseChapterList(r.FormValue("chapters"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		libraryLock.Lock()
+		if known = library[file] != nil; known {
+			setChapters(file, chapters)
+		}
+		libraryLock.Unlock()
+		if known {
+			log.Printf("Saved %d chapters for %s", len(chapters), file)
+		}
+	} else {
+		libraryLock.RLock()
+		if e := library[file]; e != nil {
+			known = true
+			chapters = e.Chapters
+		}
+		libraryLock.RUnlock()
+	}
+	if !known {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+
+	writeChapters(w, r, file, chapters)
+}
+
+// chapterImportHandler replaces the chapters of a file with those
+// embedded in the video.
+func chapterImportHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "importing chapters requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	file := r.FormValue("file")
+
+	libraryLock.RLock()
+	known := library[file] != nil
+	libraryLock.RUnlock()
+	if !known {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+
+	chapters, err := importChapters(file)
+	if err != nil {
+		log.Printf("Could not import chapters from %s: %s", file, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(chapters) == 0 {
+		http.Error(w, "the file has no chapters", http.StatusNotFound)
+		return
+	}
+
+	libraryLock.Lock()
+	if library[file] != nil {
+		setChapters(file, chapters)
+	}
+	libraryLock.Unlock()
+	log.Printf("Imported %d chapters for %s", len(chapters), file)
+
+	writeChapters(w, r, file, chapters)
+}
+
+func writeChapters(w http.ResponseWriter, r *http.Request, file string, chapters []Chapter) {
+	if r.FormValue("format") == "vtt" {
+		duration, err := fileDuration(filepath.Join(*videoDir, file))
+		if err != nil {
+			log.Printf("Could not read the length of %s: %s", file, err)
+		}
+		w.Header().Set("Content-Type", "text/vtt; charset=utf-8")
+		if err := writeChapterVTT(w, chapters, duration); err != nil {
+			log.Printf("writeChapters: write error: %s", err)
+		}
+		return
+	}
+
+	if chapters == nil {
+		chapters = []Chapter{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(chapters); err != nil {
+		log.Printf("writeChapters: encode error: %s", err)
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestWriteChapterVTT(t *testing.T) {
+	chapters := []Chapter{{Start: 0, Title: "Intro"}, {Start: 65.5, Title: "Q&A\r\nwith --> everyone"}}
+	var b bytes.Buffer
+	if err := writeChapterVTT(&b, chapters, 90); err != nil {
+		t.Fatal(err)
+	}
+	want := "WEBVTT\n\n1\n00:00:00.000 --> 00:01:05.500\nIntro\n\n2\n00:01:05.500 --> 00:01:30.000\nQ&A with -> everyone\n"
+	if b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+
+	b.Reset()
+	writeChapterVTT(&b, chapters[:1], 0)
+	if want := "WEBVTT\n\n1\n00:00:00.000 --> 24:00:00.000\nIntro\n"; b.String() != want {
+		t.Errorf("with no known length got %q, want %q", b.String(), want)
+	}
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Provenance records where an exported clip was cut from.  A clip
+// command copying the streams without encoding starts the clip at
+// the keyframe before the time asked for, so Start is worked out
+// from the length of the written clip when its container records
+// it.  End is the time asked for.
+type Provenance struct {
+	File  string
+	Start float64
+	End   float64
+}
+
+func clipsEnabled() bool {
+	return *clipCmd != ""
+}
+
+// clipName names the clip of a video between two times, such as
+// talk-clip-10s-25.5s.mp4.  Clips keep the container of the video
+// since they are cut without encoding.
+func clipName(file string, start, end float64) string {
+	ext := filepath.Ext(file)
+	stamp := func(t float64) string { return strconv.FormatFloat(t, 'f', -1, 64) + "s" }
+	return fmt.Sprintf("%s-clip-%s-%s%s", strings.TrimSuffix(file, ext), stamp(start), stamp(end), ext)
+}
+
+// clipJob cuts the clip between the start and end arguments out of a
+// video with the clip command and adds it to the library, carrying
+// over the tags of the video as an update would.  The command gets
+// {input}, {output}, {start}, {end} and {duration}, the times in
+// seconds.  Cuts are keyframe aligned when the command copies the
+// streams, see Provenance.
+func clipJob(ctx context.Context, j *Job) error {
+	start, err := strconv.ParseFloat(j.Args["start"], 64)
+	if err != nil {
+		return permanent(err)
+	}
+	end, err := strconv.ParseFloat(j.Args["end"], 64)
+	if err != nil {
+		return permanent(err)
+	}
+	name := j.Args["output"]
+	if name == "" || name != filepath.Base(name) {
+		return permanent(fmt.Errorf("bad clip name %q", name))
+	}
+
+	libraryLock.RLock()
+	parent := library[j.File]
+	_, done := library[name]
+	var tags []string
+	if parent != nil {
+		// The rules may have changed since the video was
+		// tagged, so fail before cutting if its tags no longer
+		// pass.
+		tags, err = namespaces.check(tagRules.apply(parent.Tags))
+		if _, unknown := vocab.check(tags); err == nil && vocab.Mode == vocabReject && len(unknown) > 0 {
+			err = vocabTagsError(unknown)
+		}
+	}
+	libraryLock.RUnlock()
+	if parent == nil {
+		return permanent(errors.New("the video is no longer in the library"))
+	}
+	if done {
+		return nil
+	}
+	if err != nil {
+		return permanent(err)
+	}
+
+	// The clip is written under a hidden name, which scanning
+	// skips, until it is complete.
+	out := filepath.Join(*videoDir, name)
+	if _, err := os.Stat(out); err == nil {
+		return permanent(fmt.Errorf("%s already exists", name))
+	}
+	tmp := filepath.Join(*videoDir, "."+name)
+	defer os.Remove(tmp)
+	seconds := func(t float64) string { return strconv.FormatFloat(t, 'f', 3, 64) }
+	err = runCommand(ctx, "clip", *clipCmd, map[string]string{
+		"input":    filepath.Join(*videoDir, j.File),
+		"output":   tmp,
+		"start":    seconds(start),
+		"end":      seconds(end),
+		"duration": seconds(end - start),
+	})
+	if err != nil {
+		return err
+	}
+	if st, err := os.Stat(tmp); err != nil || st.Size() == 0 {
+		return permanent(errors.New("clip command wrote no video"))
+	}
+	if err := os.Rename(tmp, out); err != nil {
+		return err
+	}
+	duration, err := fileDuration(out)
+	if err != nil {
+		log.Printf("Could not read the length of %s: %s", name, err)
+	}
+	if duration > end-start {
+		start = math.Max(0, end-duration)
+		j.logf("The clip starts at the keyframe at %s", formatTimecode(start))
+	}
+
+	title := parent.Title
+	if title == "" {
+		title = parent.Filename
+	}
+	e := &LibraryEntry{
+		Filename: name,
+		Title:    fmt.Sprintf("%s (%s to %s)", title, formatTimecode(start), formatTimecode(end)),
+		Tags:     tags,
+		Date:     parent.Date,
+		Modified: time.Now(),
+		MIME:     parent.MIME,
+		Kind:     parent.Kind,
+		ClipOf:   &Provenance{File: parent.Filename, Start: start, End: end},
+	}
+	libraryLock.Lock()
+	proposed, err := vocab.enforce(e)
+	if err != nil {
+		libraryLock.Unlock()
+		os.Remove(out)
+		return permanent(err)
+	}
+	library[name] = e
+	index.update(e)
+	dbDirty = true
+	libraryVersion++
+	libraryLock.Unlock()
+	j.logf("Added %s to the library", name)
+	if len(proposed) > 0 {
+		j.logf("These tags are waiting for approval: %s", strings.Join(proposed, ", "))
+	}
+
+	queueMediaJobs(e)
+	return nil
+}
+
+// clipsOf returns the clips cut from a video, in order.  The library
+// lock must be held.
+func clipsOf(file string) []*LibraryEntry {
+	var out []*LibraryEntry
+	for _, e := range library {
+		if e.ClipOf != nil && e.ClipOf.File == file {
+			out = append(out, e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].ClipOf.Start != out[j].ClipOf.Start {
+			return out[i].ClipOf.Start < out[j].ClipOf.Start
+		}
+		return out[i].ClipOf.End < out[j].ClipOf.End
+	})
+	return out
+}
+
+// clipsHandler lists the clips cut from a video, or on a POST queues
+// a job exporting the clip from start to end, given as timecodes.
+func clipsHandler(w http.ResponseWriter, r *http.Request) {
+	file := r.FormValue("file")
+	libraryLock.RLock()
+	e := library[file]
+	clips := clipsOf(file)
+	libraryLock.RUnlock()
+	if e == nil {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Content-Type", "application/json")
+		if clips == nil {
+			clips = []*LibraryEntry{}
+		}
+		if err := json.NewEncoder(w).Encode(clips); err != nil {
+			log.Printf("clipsHandler: encode error: %s", err)
+		}
+		return
+	}
+
+	if !clipsEnabled() {
+		http.Error(w, "clip export is not configured", http.StatusBadRequest)
+		return
+	}
+	if !timedMedia(e) {
+		http.Error(w, "clips can't be cut from a still image", http.StatusBadRequest)
+		return
+	}
+	start, err := parseTimecode(r.FormValue("start"))
+	if err != nil {
+		http.Error(w, "bad start: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	end, err := parseTimecode(r.FormValue("end"))
+	if err != nil {
+		http.Error(w, "bad end: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if end <= start {
+		http.Error(w, "the clip must end after it starts", http.StatusBadRequest)
+		return
+	}
+
+	s := struct {
+		File string
+		Job  Job
+	}{File: clipName(file, start, end)}
+	s.Job = jobs.add("clip", file, map[string]string{
+		"start":  strconv.FormatFloat(start, 'f', -1, 64),
+		"end":    strconv.FormatFloat(end, 'f', -1, 64),
+		"output": s.File,
+	})
+	log.Printf("Exporting %s", s.File)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(s); err != nil {
+		log.Printf("clipsHandler: encode error: %s", err)
+	}
+}
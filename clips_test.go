@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// withClipSetup points the video directory at a temporary one holding
+// a.mp4 and cuts clips by copying, returning a function undoing it.
+func withClipSetup(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "clips")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.mp4"), []byte("video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	oldDir, oldClip, oldThumb, oldProxy, oldPCM := *videoDir, *clipCmd, *thumbCmd, *proxyCmd, *pcmCmd
+	oldJobs, oldRules := jobs, tagRules
+	*videoDir, *clipCmd, *thumbCmd, *proxyCmd, *pcmCmd = dir, "cp {input} {output}", "thumb", "", ""
+	jobs = newJobQueue()
+	return func() {
+		*videoDir, *clipCmd, *thumbCmd, *proxyCmd, *pcmCmd = oldDir, oldClip, oldThumb, oldProxy, oldPCM
+		jobs, tagRules = oldJobs, oldRules
+		os.RemoveAll(dir)
+	}
+}
+
+func TestClipJob(t *testing.T) {
+	defer withClipSetup(t)()
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4", Title: "Holiday", Kind: kindVideo, Tags: []string{"loc/beach"}})()
+	rules, err := parseTagRules("loc = location\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagRules = rules
+
+	name := clipName("a.mp4", 5, 10)
+	j := &Job{Kind: "clip", File: "a.mp4", Args: map[string]string{"start": "5", "end": "10", "output": name}}
+	if err := clipJob(context.Background(), j); err != nil {
+		t.Fatal(err)
+	}
+
+	e := library[name]
+	if e == nil {
+		t.Fatalf("%s wasn't added to the library", name)
+	}
+	if got := strings.Join(e.Tags, ","); got != "location/beach" {
+		t.Errorf("clip tags %q, want the tag rules applied", got)
+	}
+	if !index.contains(name, []string{stem("holiday")}) {
+		t.Error("the clip wasn't indexed")
+	}
+	for _, kind := range []string{"probe", "thumbnail", "storyboard"} {
+		if !jobs.active(kind, name) {
+			t.Errorf("no %s job queued for the clip", kind)
+		}
+	}
+	for _, kind := range []string{"proxy", "waveform"} {
+		if jobs.active(kind, name) {
+			t.Errorf("%s job queued without a command for it", kind)
+		}
+	}
+}
+
+func TestClipJobRejectsUnknownTags(t *testing.T) {
+	defer withClipSetup(t)()
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4", Kind: kindVideo, Tags: []string{"beach"}})()
+	old := vocab
+	defer func() { vocab = old }()
+	vocab = newVocabulary()
+	vocab.Mode = vocabReject
+
+	name := clipName("a.mp4", 5, 10)
+	j := &Job{Kind: "clip", File: "a.mp4", Args: map[string]string{"start": "5", "end": "10", "output": name}}
+	err := clipJob(context.Background(), j)
+	if _, ok := err.(permanentError); !ok {
+		t.Fatalf("got %v, want a permanent error", err)
+	}
+	if _, err := os.Stat(filepath.Join(*videoDir, name)); !os.IsNotExist(err) {
+		t.Error("the clip was cut anyway")
+	}
+}
+
+func TestClipJobRecordsKeyframeStart(t *testing.T) {
+	defer withClipSetup(t)()
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4", Title: "Holiday", Kind: kindVideo})()
+	// Copying the file makes a clip of 7s, as if the cut went back
+	// to a keyframe 2s before the start asked for.
+	mvhd := bytes.Join([][]byte{{0, 0, 0, 0}, be32(0), be32(0), be32(1000), be32(7000)}, nil)
+	video := append(mp4("ftyp", []byte("isom")), mp4("moov", mp4("mvhd", mvhd))...)
+	if err := ioutil.WriteFile(filepath.Join(*videoDir, "a.mp4"), video, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	name := clipName("a.mp4", 5, 10)
+	j := &Job{Kind: "clip", File: "a.mp4", Args: map[string]string{"start": "5", "end": "10", "output": name}}
+	if err := clipJob(context.Background(), j); err != nil {
+		t.Fatal(err)
+	}
+	e := library[name]
+	if e == nil {
+		t.Fatalf("%s wasn't added to the library", name)
+	}
+	if e.ClipOf.Start != 3 || e.ClipOf.End != 10 {
+		t.Errorf("clip of %+v, want it to start at the keyframe", e.ClipOf)
+	}
+	if e.Title != "Holiday (00:03 to 00:10)" {
+		t.Errorf("title %q", e.Title)
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// maxCommandOutput is how much of the end of the error output of a
+// failed command is kept for its error.
+const maxCommandOutput = 300
+
+// runCommand runs a command line configured by a flag, such as the
+// thumbnail command.  The line is split into words, and {name} in a
+// word is replaced by vars[name], so values with spaces stay whole.
+// It isn't run through a shell.
+func runCommand(ctx context.Context, name, line string, vars map[string]string) error {
+	return streamCommand(ctx, name, line, vars, nil)
+}
+
+// streamCommand runs a command line like runCommand, writing what the
+// command prints to stdout.
+func streamCommand(ctx context.Context, name, line string, vars map[string]string, stdout io.Writer) error {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return permanent(fmt.Errorf("no %s command configured", name))
+	}
+	var pairs []string
+	for k, v := range vars {
+		pairs = append(pairs, "{"+k+"}", v)
+	}
+	r := strings.NewReplacer(pairs...)
+	args := make([]string, len(words))
+	for i, w := range words {
+		args[i] = r.Replace(w)
+	}
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	var stderr bytes.Buffer
+	cmd.Stdout = stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxCommandOutput {
+			msg = "..." + msg[len(msg)-maxCommandOutput:]
+		}
+		if msg != "" {
+			return fmt.Errorf("%s command: %s: %s", name, err, msg)
+		}
+		return fmt.Errorf("%s command: %s", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// This file reads just enough of the MP4 and Matroska container
+// formats to pull metadata out of video files without decoding them.
+
+// mp4Box is a box of an MP4 file, with the offset and length of its
+// payload.
+type mp4Box struct {
+	Type  string
+	Start int64
+	Size  int64
+}
+
+// mp4Boxes lists the boxes between start and end.
+func mp4Boxes(r io.ReadSeeker, start, end int64) ([]mp4Box, error) {
+	var boxes []mp4Box
+	var hdr [16]byte
+	for pos := start; pos+8 <= end; {
+		if _, err := r.Seek(pos, io.SeekStart); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(r, hdr[:8]); err != nil {
+			return nil, err
+		}
+		size := int64(binary.BigEndian.Uint32(hdr[:4]))
+		b := mp4Box{Type: string(hdr[4:8]), Start: pos + 8}
+		switch size {
+		case 0:
+			size = end - pos
+		case 1:
+			if _, err := io.ReadFull(r, hdr[8:16]); err != nil {
+				return nil, err
+			}
+			size = int64(binary.BigEndian.Uint64(hdr[8:16]))
+			b.Start += 8
+		}
+		if size < b.Start-pos || pos+size > end {
+			return nil, fmt.Errorf("mp4: bad size for %q box at %d", b.Type, pos)
+		}
+		b.Size = pos + size - b.Start
+		boxes = append(boxes, b)
+		pos += size
+	}
+	return boxes, nil
+}
+
+// mp4Find follows a path of box types down from the top of the file,
+// such as moov, udta, chpl.  It returns nil if there is no such box.
+func mp4Find(r io.ReadSeeker, path ...string) (*mp4Box, error) {
+	end, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	box := &mp4Box{Start: 0, Size: end}
+	for _, typ := range path {
+		boxes, err := mp4Boxes(r, box.Start, box.Start+box.Size)
+		if err != nil {
+			return nil, err
+		}
+		box = nil
+		for i := range boxes {
+			if boxes[i].Type == typ {
+				box = &boxes[i]
+				break
+			}
+		}
+		if box == nil {
+			return nil, nil
+		}
+	}
+	return box, nil
+}
+
+// mp4Read returns the payload of a box.
+func mp4Read(r io.ReadSeeker, b *mp4Box, limit int64) ([]byte, error) {
+	if b.Size > limit {
+		return nil, fmt.Errorf("mp4: %q box is too large", b.Type)
+	}
+	if _, err := r.Seek(b.Start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, b.Size)
+	_, err := io.ReadFull(r, buf)
+	return buf, err
+}
+
+// Matroska element IDs, written with their length marker as the
+// specification does.
+const (
+	ebmlHeaderID       = 0x1A45DFA3
+	ebmlDocTypeID      = 0x4282
+	mkvSegment         = 0x18538067
+	mkvChaptersID      = 0x1043A770
+	mkvEditionEntry    = 0x45B9
+	mkvChapterAtom     = 0xB6
+	mkvChapterStart    = 0x91
+	mkvChapterHidden   = 0x98
+	mkvChapterDisplay  = 0x80
+	mkvChapterString   = 0x85
+	mkvAttachments     = 0x1941A469
+	mkvAttachedFile    = 0x61A7
+	mkvFileName        = 0x466E
+	mkvFileMimeType    = 0x4660
+	mkvFileData        = 0x465C
+	mkvInfo            = 0x1549A966
+	mkvTimecodeScale   = 0x2AD7B1
+	mkvDuration        = 0x4489
+	ebmlUnknownSize    = -1
+	ebmlMaxElementSize = 16 << 20
+)
+
+// ebmlElement is an element of a Matroska file, with the offset and
+// length of its payload.
+type ebmlElement struct {
+	ID    uint64
+	Start int64
+	Size  int64
+}
+
+// readVint reads an EBML variable length integer.  IDs keep their
+// length marker, sizes drop it.
+func readVint(r io.ByteReader, keepMarker bool) (uint64, int, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	n := 1
+	for mask := byte(0x80); b&mask == 0; mask >>= 1 {
+		if mask == 1 {
+			return 0, 0, errors.New("ebml: bad variable length integer")
+		}
+		n++
+	}
+	v := uint64(b)
+	if !keepMarker {
+		v &= uint64(0xFF >> uint(n))
+	}
+	allOnes := v == uint64(0xFF>>uint(n))
+	for i := 1; i < n; i++ {
+		c, err := r.ReadByte()
+		if err != nil {
+			return 0, 0, err
+		}
+		v = v<<8 | uint64(c)
+		allOnes = allOnes && c == 0xFF
+	}
+	if !keepMarker && allOnes {
+		return 0, n, errUnknownSize
+	}
+	return v, n, nil
+}
+
+var errUnknownSize = errors.New("ebml: unknown size")
+
+// byteCounter tracks the position in a stream read byte by byte.
+type byteCounter struct {
+	r   io.Reader
+	pos int64
+	buf [1]byte
+}
+
+func (c *byteCounter) ReadByte() (byte, error) {
+	if _, err := io.ReadFull(c.r, c.buf[:]); err != nil {
+		return 0, err
+	}
+	c.pos++
+	return c.buf[0], nil
+}
+
+// readEBMLHeader reads the ID and size of the element at the current
+// position of r, which is at offset pos in the file.
+func readEBMLHeader(r io.Reader, pos int64) (ebmlElement, error) {
+	c := &byteCounter{r: r, pos: pos}
+	id, _, err := readVint(c, true)
+	if err != nil {
+		return ebmlElement{}, err
+	}
+	size, _, err := readVint(c, false)
+	el := ebmlElement{ID: id, Size: int64(size)}
+	if err == errUnknownSize {
+		el.Size = ebmlUnknownSize
+	} else if err != nil {
+		return ebmlElement{}, err
+	}
+	el.Start = c.pos
+	return el, nil
+}
+
+// ebmlChildren lists the elements inside a payload read into memory.
+func ebmlChildren(data []byte) ([]ebmlElement, error) {
+	var out []ebmlElement
+	r := bytes.NewReader(data)
+	for pos := int64(0); pos < int64(len(data)); {
+		el, err := readEBMLHeader(r, pos)
+		if err != nil {
+			return nil, err
+		}
+		if el.Size == ebmlUnknownSize || el.Start+el.Size > int64(len(data)) {
+			return nil, fmt.Errorf("ebml: bad size for element %x", el.ID)
+		}
+		out = append(out, el)
+		pos = el.Start + el.Size
+		r.Seek(pos, io.SeekStart)
+	}
+	return out, nil
+}
+
+// ebmlUint decodes an unsigned integer payload.
+func ebmlUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+// mkvTopLevel calls fn for every top level element of the segment of
+// a Matroska file.  Reading stops at the first element of unknown
+// size, usually a cluster being streamed, since the rest of the
+// segment can't be walked without parsing it.  fn returns false to
+// stop early.
+func mkvTopLevel(r io.ReadSeeker, fn func(ebmlElement) (bool, error)) error {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	el, err := readEBMLHeader(r, 0)
+	if err != nil {
+		return err
+	}
+	if el.ID != ebmlHeaderID {
+		return errors.New("not a Matroska file")
+	}
+	pos := el.Start + el.Size
+	if _, err := r.Seek(pos, io.SeekStart); err != nil {
+		return err
+	}
+	seg, err := readEBMLHeader(r, pos)
+	if err != nil {
+		return err
+	}
+	if seg.ID != mkvSegment {
+		return errors.New("Matroska file has no segment")
+	}
+
+	end := seg.Start + seg.Size
+	for pos = seg.Start; seg.Size == ebmlUnknownSize || pos < end; {
+		if _, err := r.Seek(pos, io.SeekStart); err != nil {
+			return err
+		}
+		el, err := readEBMLHeader(r, pos)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if el.Size == ebmlUnknownSize {
+			return nil
+		}
+		more, err := fn(el)
+		if err != nil || !more {
+			return err
+		}
+		pos = el.Start + el.Size
+	}
+	return nil
+}
+
+// mkvRead returns the payload of an element.
+func mkvRead(r io.ReadSeeker, el ebmlElement, limit int64) ([]byte, error) {
+	if el.Size > limit {
+		return nil, fmt.Errorf("ebml: element %x is too large", el.ID)
+	}
+	if _, err := r.Seek(el.Start, io.SeekStart); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, el.Size)
+	_, err := io.ReadFull(r, buf)
+	return buf, err
+}
+
+// mediaDuration returns the length in seconds recorded in the header
+// of an MP4 or Matroska file, or zero for other files and files that
+// don't record it.
+func mediaDuration(r io.ReadSeeker) (float64, error) {
+	var head [8]byte
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return 0, nil
+	}
+	if binary.BigEndian.Uint32(head[:4]) == ebmlHeaderID {
+		return mkvMediaDuration(r)
+	}
+	switch string(head[4:8]) {
+	case "ftyp", "moov", "mdat", "wide", "free":
+		return mp4MediaDuration(r)
+	}
+	return 0, nil
+}
+
+// mp4MediaDuration reads the duration of the movie header.
+func mp4MediaDuration(r io.ReadSeeker) (float64, error) {
+	box, err := mp4Find(r, "moov", "mvhd")
+	if err != nil || box == nil {
+		return 0, err
+	}
+	data, err := mp4Read(r, box, 1<<10)
+	if err != nil {
+		return 0, err
+	}
+	// Version 1 headers have 64 bit times and duration.
+	var scale, duration uint64
+	switch {
+	case len(data) >= 20 && data[0] == 0:
+		scale = uint64(binary.BigEndian.Uint32(data[12:16]))
+		duration = uint64(binary.BigEndian.Uint32(data[16:20]))
+	case len(data) >= 32 && data[0] == 1:
+		scale = uint64(binary.BigEndian.Uint32(data[20:24]))
+		duration = binary.BigEndian.Uint64(data[24:32])
+	default:
+		return 0, errors.New("mp4: bad movie header")
+	}
+	if scale == 0 || duration == math.MaxUint32 || duration == math.MaxUint64 {
+		return 0, nil
+	}
+	return float64(duration) / float64(scale), nil
+}
+
+// mkvMediaDuration reads the duration of the segment information.
+func mkvMediaDuration(r io.ReadSeeker) (float64, error) {
+	var duration float64
+	err := mkvTopLevel(r, func(el ebmlElement) (bool, error) {
+		if el.ID != mkvInfo {
+			return true, nil
+		}
+		data, err := mkvRead(r, el, ebmlMaxElementSize)
+		if err != nil {
+			return false, err
+		}
+		children, err := ebmlChildren(data)
+		if err != nil {
+			return false, err
+		}
+		scale, ticks := uint64(1000000), 0.0
+		for _, c := range children {
+			b := data[c.Start : c.Start+c.Size]
+			switch c.ID {
+			case mkvTimecodeScale:
+				scale = ebmlUint(b)
+			case mkvDuration:
+				switch len(b) {
+				case 4:
+					ticks = float64(math.Float32frombits(binary.BigEndian.Uint32(b)))
+				case 8:
+					ticks = math.Float64frombits(binary.BigEndian.Uint64(b))
+				}
+			}
+		}
+		duration = ticks * float64(scale) / 1e9
+		return false, nil
+	})
+	return duration, err
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// mp4 builds a box around its payload.
+func mp4(typ string, payload ...[]byte) []byte {
+	body := bytes.Join(payload, nil)
+	b := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(b, uint32(8+len(body)))
+	copy(b[4:], typ)
+	return append(b, body...)
+}
+
+// ebml builds an element around its payload, with an ID written with
+// its length marker and an eight byte size, or an unknown size for a
+// negative one.
+func ebml(id uint64, size int, payload ...[]byte) []byte {
+	var b []byte
+	for shift := uint(24); ; shift -= 8 {
+		if c := byte(id >> shift); c != 0 || len(b) > 0 || shift == 0 {
+			b = append(b, c)
+		}
+		if shift == 0 {
+			break
+		}
+	}
+	body := bytes.Join(payload, nil)
+	if size < 0 {
+		b = append(b, 0xFF)
+	} else {
+		var n [8]byte
+		binary.BigEndian.PutUint64(n[:], uint64(len(body)))
+		n[0] = 0x01
+		b = append(b, n[:]...)
+	}
+	return append(b, body...)
+}
+
+func be32(v uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return b[:]
+}
+
+func be64(v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	return b[:]
+}
+
+func TestReadVint(t *testing.T) {
+	tests := []struct {
+		in         []byte
+		keepMarker bool
+		want       uint64
+		n          int
+		err        error
+	}{
+		{[]byte{0x81}, false, 1, 1, nil},
+		{[]byte{0x40, 0x02}, false, 2, 2, nil},
+		{[]byte{0x1A, 0x45, 0xDF, 0xA3}, true, ebmlHeaderID, 4, nil},
+		{[]byte{0x01, 0, 0, 0, 0, 0, 1, 0}, false, 256, 8, nil},
+		{[]byte{0xFF}, false, 0, 1, errUnknownSize},
+		{[]byte{0x7F, 0xFF}, false, 0, 2, errUnknownSize},
+		{[]byte{0xFF}, true, 0xFF, 1, nil},
+	}
+	for _, tt := range tests {
+		v, n, err := readVint(bytes.NewReader(tt.in), tt.keepMarker)
+		if v != tt.want || n != tt.n || err != tt.err {
+			t.Errorf("readVint(% x, %v) = %d, %d, %v, want %d, %d, %v", tt.in, tt.keepMarker, v, n, err, tt.want, tt.n, tt.err)
+		}
+	}
+	for _, in := range [][]byte{{0x00}, {0x40}, {}} {
+		if _, _, err := readVint(bytes.NewReader(in), false); err == nil {
+			t.Errorf("readVint(% x) gave no error", in)
+		}
+	}
+}
+
+func TestMP4Find(t *testing.T) {
+	// The mdat box uses a 64 bit size.
+	mdat := append(append(be32(1), "mdat"...), be64(16+4)...)
+	mdat = append(mdat, "data"...)
+	file := bytes.Join([][]byte{
+		mp4("ftyp", []byte("isom")),
+		mdat,
+		mp4("moov", mp4("trak"), mp4("udta", mp4("chpl", []byte("chapters")))),
+	}, nil)
+	r := bytes.NewReader(file)
+
+	box, err := mp4Find(r, "moov", "udta", "chpl")
+	if err != nil || box == nil {
+		t.Fatalf("mp4Find = %v, %v", box, err)
+	}
+	data, err := mp4Read(r, box, 1<<10)
+	if err != nil || string(data) != "chapters" {
+		t.Errorf("mp4Read = %q, %v", data, err)
+	}
+	if _, err := mp4Read(r, box, 4); err == nil {
+		t.Error("mp4Read read past its limit")
+	}
+	if box, err := mp4Find(r, "moov", "meta"); box != nil || err != nil {
+		t.Errorf("mp4Find of a missing box = %v, %v", box, err)
+	}
+
+	bad := append(mp4("ftyp", []byte("isom")), append(be32(100), "moov"...)...)
+	if _, err := mp4Find(bytes.NewReader(bad), "moov"); err == nil {
+		t.Error("no error for a box running past the end of the file")
+	}
+}
+
+func TestEBMLChildren(t *testing.T) {
+	data := append(ebml(mkvChapterStart, 0, []byte{0x01, 0x00}), ebml(mkvChapterString, 0, []byte("Intro"))...)
+	children, err := ebmlChildren(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(children) != 2 || children[0].ID != mkvChapterStart || children[1].ID != mkvChapterString {
+		t.Fatalf("ebmlChildren = %+v", children)
+	}
+	if v := ebmlUint(data[children[0].Start : children[0].Start+children[0].Size]); v != 256 {
+		t.Errorf("ebmlUint = %d, want 256", v)
+	}
+	if _, err := ebmlChildren(data[:len(data)-1]); err == nil {
+		t.Error("no error for a truncated element")
+	}
+}
+
+func TestMKVTopLevel(t *testing.T) {
+	const tags, cluster = 0x1254C367, 0x1F43B675
+	file := bytes.Join([][]byte{
+		ebml(ebmlHeaderID, 0),
+		ebml(mkvSegment, -1,
+			ebml(mkvChaptersID, 0),
+			ebml(tags, 0),
+			ebml(cluster, -1), // being streamed
+			ebml(mkvChaptersID, 0)),
+	}, nil)
+	r := bytes.NewReader(file)
+	for i := 0; i < 2; i++ {
+		// The second walk starts where the first left off.
+		var seen []uint64
+		err := mkvTopLevel(r, func(el ebmlElement) (bool, error) {
+			seen = append(seen, el.ID)
+			return true, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(seen) != 2 || seen[0] != mkvChaptersID || seen[1] != tags {
+			t.Errorf("visited %x, want the elements before the cluster", seen)
+		}
+	}
+
+	if err := mkvTopLevel(bytes.NewReader(mp4("ftyp")), nil); err == nil {
+		t.Error("no error for a file that isn't Matroska")
+	}
+}
+
+func TestMediaDuration(t *testing.T) {
+	mvhd0 := bytes.Join([][]byte{{0, 0, 0, 0}, be32(0), be32(0), be32(1000), be32(90500)}, nil)
+	mvhd1 := bytes.Join([][]byte{{1, 0, 0, 0}, be64(0), be64(0), be32(600), be64(600 * 7200)}, nil)
+	mkv := func(info ...[]byte) []byte {
+		return append(ebml(ebmlHeaderID, 0, ebml(ebmlDocTypeID, 0, []byte("webm"))),
+			ebml(mkvSegment, -1, ebml(mkvInfo, 0, info...))...)
+	}
+	tests := []struct {
+		name string
+		file []byte
+		want float64
+	}{
+		{"mp4 version 0", append(mp4("ftyp", []byte("isom")), mp4("moov", mp4("mvhd", mvhd0))...), 90.5},
+		{"mp4 version 1", append(mp4("ftyp", []byte("isom")), mp4("moov", mp4("mvhd", mvhd1))...), 7200},
+		{"mp4 without a header", mp4("ftyp", []byte("isom")), 0},
+		{"mkv float64", mkv(ebml(mkvDuration, 0, be64(math.Float64bits(12500)))), 12.5},
+		{"mkv float32 with scale", mkv(
+			ebml(mkvTimecodeScale, 0, []byte{0x0F, 0x42, 0x40}), // 1ms in ns
+			ebml(mkvDuration, 0, be32(math.Float32bits(3000)))), 3},
+		{"mkv without a duration", mkv(), 0},
+		{"other", []byte("OggS\x00\x02\x00\x00\x00\x00"), 0},
+		{"short", []byte("ab"), 0},
+	}
+	for _, tt := range tests {
+		got, err := mediaDuration(bytes.NewReader(tt.file))
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: duration %g, want %g", tt.name, got, tt.want)
+		}
+	}
+}
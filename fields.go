@@ -0,0 +1,291 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The types a custom field can have.
+const (
+	fieldString  = "string"
+	fieldNumber  = "number"
+	fieldDate    = "date"
+	fieldEnum    = "enum"
+	fieldBoolean = "boolean"
+	fieldURL     = "url"
+)
+
+// Field is an administrator defined piece of metadata, such as the
+// camera operator or a rating.  The values are kept in the Extra map
+// of each entry, written in a canonical form for their type.
+type Field struct {
+	Name string
+	Type string
+
+	// Values lists the choices of an enum field.
+	Values []string `json:",omitempty"`
+}
+
+// normalize checks a value against the field's type and returns it
+// in canonical form.
+func (f *Field) normalize(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch f.Type {
+	case fieldString:
+		return value, nil
+	case fieldNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", fmt.Errorf("%s must be a number", f.Name)
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case fieldDate:
+		d, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return "", fmt.Errorf("%s must be a date written as YYYY-MM-DD", f.Name)
+		}
+		return d.Format("2006-01-02"), nil
+	case fieldEnum:
+		for _, v := range f.Values {
+			if strings.EqualFold(v, value) {
+				return v, nil
+			}
+		}
+		return "", fmt.Errorf("%s must be one of %s", f.Name, strings.Join(f.Values, ", "))
+	case fieldBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf("%s must be true or false", f.Name)
+		}
+		return strconv.FormatBool(b), nil
+	case fieldURL:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "", fmt.Errorf("%s must be an http or https URL", f.Name)
+		}
+		return u.String(), nil
+	}
+	return "", fmt.Errorf("%s has unknown type %q", f.Name, f.Type)
+}
+
+// fieldSchema holds the custom fields.  Like the namespaces it has
+// its own lock since queries are parsed with and without libraryLock
+// held.
+type fieldSchema struct {
+	sync.RWMutex
+	byName map[string]*Field
+	order  []*Field
+}
+
+var customFields = &fieldSchema{byName: make(map[string]*Field)}
+
+// get returns the field with the given name, or nil.
+func (s *fieldSchema) get(name string) *Field {
+	s.RLock()
+	defer s.RUnlock()
+	return s.byName[strings.ToLower(name)]
+}
+
+// list returns the fields in the order they were defined.
+func (s *fieldSchema) list() []*Field {
+	s.RLock()
+	defer s.RUnlock()
+	return s.order
+}
+
+// set replaces the fields.
+func (s *fieldSchema) set(list []*Field) {
+	byName := make(map[string]*Field)
+	for _, f := range list {
+		byName[f.Name] = f
+	}
+	s.Lock()
+	s.byName = byName
+	s.order = list
+	s.Unlock()
+}
+
+// fieldValuesError is returned by updates with custom field values
+// that don't fit the schema.
+type fieldValuesError []string
+
+func (e fieldValuesError) Error() string {
+	return strings.Join(e, "; ")
+}
+
+// check validates the custom field values of an entry and returns
+// them in canonical form.  Blank values are dropped.
+func (s *fieldSchema) check(extra map[string]string) (map[string]string, error) {
+	var errs fieldValuesError
+	out := make(map[string]string)
+	for name, value := range extra {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		f := s.get(name)
+		if f == nil {
+			errs = append(errs, fmt.Sprintf("unknown field %q", name))
+			continue
+		}
+		v, err := f.normalize(value)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		out[f.Name] = v
+	}
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, errs
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return out, nil
+}
+
+// text returns the values of the text like fields of an entry, which
+// are added to the search index.
+func (s *fieldSchema) text(e *LibraryEntry) []string {
+	var out []string
+	for name, v := range e.Extra {
+		if f := s.get(name); f != nil && (f.Type == fieldString || f.Type == fieldEnum) {
+			out = append(out, v)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// parseFields reads custom fields written one per line as
+//
+//     name type [= choice, other choice]
+//
+// where choices are only given for enum fields, such as
+// "license enum = cc-by, cc0".  Blank lines and lines starting with #
+// are ignored.
+func parseFields(text string) ([]*Field, error) {
+	var out []*Field
+	seen := make(map[string]bool)
+	sc := bufio.NewScanner(strings.NewReader(text))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		f := &Field{}
+		if i := strings.Index(line, "="); i >= 0 {
+			f.Values = splitValues([]string{line[i+1:]})
+			line = line[:i]
+		}
+		words := strings.Fields(line)
+		if len(words) != 2 {
+			return nil, fmt.Errorf("line %d: fields are written as name type", n)
+		}
+		f.Name = strings.ToLower(words[0])
+		f.Type = strings.ToLower(words[1])
+
+		// Field names share the query syntax with the built in
+		// fields and the tag namespaces.
+		switch {
+		case !namespaceName.MatchString(f.Name):
+			return nil, fmt.Errorf("line %d: %q is not a valid field name", n, words[0])
+		case isQueryField(f.Name):
+			return nil, fmt.Errorf("line %d: %q is already a search field", n, f.Name)
+		case namespaces.get(f.Name) != nil:
+			return nil, fmt.Errorf("line %d: %q is already a tag namespace", n, f.Name)
+		case seen[f.Name]:
+			return nil, fmt.Errorf("line %d: field %q is defined twice", n, f.Name)
+		}
+		seen[f.Name] = true
+
+		switch f.Type {
+		case fieldEnum:
+			if len(f.Values) == 0 {
+				return nil, fmt.Errorf("line %d: enum fields need their choices, as %s enum = a, b", n, f.Name)
+			}
+		case fieldString, fieldNumber, fieldDate, fieldBoolean, fieldURL:
+			if f.Values != nil {
+				return nil, fmt.Errorf("line %d: only enum fields take choices", n)
+			}
+		default:
+			return nil, fmt.Errorf("line %d: unknown type %q", n, words[1])
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// formatFields writes fields in the format parseFields reads.
+func formatFields(list []*Field) string {
+	var lines []string
+	for _, f := range list {
+		line := f.Name + " " + f.Type
+		if len(f.Values) > 0 {
+			line += " = " + strings.Join(f.Values, ", ")
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func fieldsHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s := struct {
+		Config string
+		Error  string
+	}{}
+
+	if r.Method == http.MethodPost {
+		s.Config = r.FormValue("fields")
+		list, err := parseFields(s.Config)
+		if err != nil {
+			if wantJSON(r) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			s.Error = err.Error()
+		} else {
+			log.Printf("Updating custom fields: %d fields", len(list))
+			libraryLock.Lock()
+			customFields.set(list)
+			index.rebuild(library)
+			dbDirty = true
+			libraryVersion++
+			libraryLock.Unlock()
+		}
+	}
+
+	list := customFields.list()
+	if s.Error == "" {
+		s.Config = formatFields(list)
+	}
+
+	if wantJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(list); err != nil {
+			log.Printf("fieldsHandler: encode error: %s", err)
+		}
+		return
+	}
+
+	err := fildTmpl.ExecuteTemplate(w, "layout", s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// withFields replaces the custom fields, returning a function that
+// restores them.
+func withFields(t *testing.T, config string) func() {
+	list, err := parseFields(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := customFields.list()
+	customFields.set(list)
+	return func() { customFields.set(old) }
+}
+
+func TestParseFields(t *testing.T) {
+	list, err := parseFields("# rights\n\nLicense ENUM = cc-by, cc0\nrating number\nsource url\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Field{
+		{Name: "license", Type: fieldEnum, Values: []string{"cc-by", "cc0"}},
+		{Name: "rating", Type: fieldNumber},
+		{Name: "source", Type: fieldURL},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("got %+v, want %+v", list, want)
+	}
+	text := "license enum = cc-by, cc0\nrating number\nsource url"
+	if got := formatFields(list); got != text {
+		t.Errorf("formatFields = %q, want %q", got, text)
+	}
+
+	for _, in := range []string{
+		"rating",
+		"rating number extra",
+		"rating integer",
+		"license enum",
+		"rating number = 1, 2",
+		"date date",
+		"rating number\nrating string",
+		"2nd string",
+	} {
+		if _, err := parseFields(in); err == nil {
+			t.Errorf("parseFields(%q) gave no error", in)
+		}
+	}
+
+	defer withNamespaces(t, "person")()
+	if _, err := parseFields("person string"); err == nil {
+		t.Error("a field took the name of a namespace")
+	}
+}
+
+func TestFieldNormalize(t *testing.T) {
+	tests := []struct {
+		typ, in, want string
+	}{
+		{fieldString, "  Ann ", "Ann"},
+		{fieldNumber, "04.50", "4.5"},
+		{fieldNumber, "1e3", "1000"},
+		{fieldDate, "2017-03-01", "2017-03-01"},
+		{fieldEnum, "CC0", "cc0"},
+		{fieldBoolean, "T", "true"},
+		{fieldBoolean, "0", "false"},
+		{fieldURL, "https://example.com/a b", "https://example.com/a%20b"},
+	}
+	for _, tt := range tests {
+		f := &Field{Name: "f", Type: tt.typ, Values: []string{"cc-by", "cc0"}}
+		got, err := f.normalize(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("%s %q = %q, %v; want %q", tt.typ, tt.in, got, err, tt.want)
+		}
+	}
+
+	bad := []struct{ typ, in string }{
+		{fieldNumber, "four"},
+		{fieldDate, "2017-3-1"},
+		{fieldEnum, "gpl"},
+		{fieldBoolean, "maybe"},
+		{fieldURL, "ftp://example.com"},
+		{fieldURL, "example.com"},
+		{"color", "red"},
+	}
+	for _, tt := range bad {
+		f := &Field{Name: "f", Type: tt.typ, Values: []string{"cc-by", "cc0"}}
+		if got, err := f.normalize(tt.in); err == nil {
+			t.Errorf("%s %q = %q, want an error", tt.typ, tt.in, got)
+		}
+	}
+}
+
+func TestFieldCheck(t *testing.T) {
+	defer withFields(t, "rating number\nlicense enum = cc-by, cc0")()
+
+	got, err := customFields.check(map[string]string{"Rating": "4.0", "license": "CC-BY", "empty": " "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"rating": "4", "license": "cc-by"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got, err := customFields.check(map[string]string{"rating": " "}); got != nil || err != nil {
+		t.Errorf("blank values = %v, %v; want nothing", got, err)
+	}
+	if _, err := customFields.check(map[string]string{"rating": "high", "colour": "red"}); err == nil {
+		t.Error("no error for bad and unknown fields")
+	} else if want := `rating must be a number; unknown field "colour"`; err.Error() != want {
+		t.Errorf("error %q, want %q", err, want)
+	}
+}
+
+func TestCustomFieldQueries(t *testing.T) {
+	defer withFields(t, "rating number\nlicense enum = cc-by, cc0\nshot date\nfree boolean\nsource url")()
+	e := &LibraryEntry{Extra: map[string]string{"rating": "4", "license": "cc0", "shot": "2017-03-01", "free": "true"}}
+
+	tests := map[string]bool{
+		"rating>3":       true,
+		"rating>=4":      true,
+		"rating<4":       false,
+		"rating!=4":      false,
+		"license=CC0":    true,
+		"license!=cc0":   false,
+		"shot:2017-03":   true,
+		"shot<2017":      false,
+		"free=1":         true,
+		"missing:rating": false,
+		"missing:source": true,
+	}
+	for q, want := range tests {
+		n, err := parseQuery(q)
+		if err != nil {
+			t.Errorf("parseQuery(%q): %s", q, err)
+			continue
+		}
+		if got := n.match(e); got != want {
+			t.Errorf("%s = %v, want %v", q, got, want)
+		}
+	}
+	for _, q := range []string{"rating>four", "license>cc0", "free:yes", "missing:colour"} {
+		if _, err := parseQuery(q); err == nil {
+			t.Errorf("parseQuery(%q) gave no error", q)
+		}
+	}
+}
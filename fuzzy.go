@@ -0,0 +1,314 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// editDistance returns the optimal string alignment distance between
+// a and b, which is the Levenshtein distance with transpositions of
+// adjacent letters counted as a single edit.  Once the distance is
+// known to exceed max the search gives up and returns max+1.
+func editDistance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if d := len(ra) - len(rb); d > max || -d > max {
+		return max + 1
+	}
+
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		best := cur[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d := prev[j-1] + cost
+			if v := prev[j] + 1; v < d {
+				d = v
+			}
+			if v := cur[j-1] + 1; v < d {
+				d = v
+			}
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				if v := prev2[j-2] + 1; v < d {
+					d = v
+				}
+			}
+			cur[j] = d
+			if d < best {
+				best = d
+			}
+		}
+		if best > max {
+			return max + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	if prev[len(rb)] > max {
+		return max + 1
+	}
+	return prev[len(rb)]
+}
+
+// maxEdits is how many typos are tolerated in a word.  Short words
+// have too many neighbours to be corrected usefully.
+func maxEdits(word string) int {
+	switch n := len([]rune(word)); {
+	case n <= 3:
+		return 0
+	case n <= 6:
+		return 1
+	}
+	return 2
+}
+
+// closest returns the candidates within maxEdits of word, closest
+// first and then most frequent first.
+func closest(word string, candidates map[string]int) []string {
+	max := maxEdits(word)
+	if max == 0 {
+		return nil
+	}
+
+	type match struct {
+		word  string
+		dist  int
+		count int
+	}
+	var matches []match
+	for c, n := range candidates {
+		if c == word {
+			continue
+		}
+		if d := editDistance(word, c, max); d <= max {
+			matches = append(matches, match{c, d, n})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].dist != matches[j].dist {
+			return matches[i].dist < matches[j].dist
+		}
+		if matches[i].count != matches[j].count {
+			return matches[i].count > matches[j].count
+		}
+		return matches[i].word < matches[j].word
+	})
+
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.word
+	}
+	return out
+}
+
+// fuzzyStems returns the stems of the known words that are close to
+// a word that isn't in the vocabulary.
+func (ix *textIndex) fuzzyStems(word string) []string {
+	ix.RLock()
+	defer ix.RUnlock()
+	if _, ok := ix.words[word]; ok {
+		return nil
+	}
+
+	var stems []string
+	seen := make(map[string]bool)
+	for _, w := range closest(word, ix.wordCount) {
+		if s := ix.words[w]; !seen[s] {
+			seen[s] = true
+			stems = append(stems, s)
+		}
+	}
+	return stems
+}
+
+// tagAlternatives returns the tags a tag search should match.  Known
+// tags, including ancestors in the hierarchy that aren't used
+// directly, match only themselves, unknown ones match the tags that
+// are a typo away.
+func (ix *textIndex) tagAlternatives(tag string) []string {
+	ix.RLock()
+	defer ix.RUnlock()
+	tag = strings.ToLower(tag)
+	if ix.tags[tag] > 0 {
+		return []string{tag}
+	}
+	for t := range ix.tags {
+		if strings.HasPrefix(t, tag+tagSeparator) {
+			return []string{tag}
+		}
+	}
+	return append([]string{tag}, closest(tag, ix.tags)...)
+}
+
+// suggest returns the best correction for a word, or the empty
+// string if the word is known or nothing is close.
+func (ix *textIndex) suggest(word string) string {
+	ix.RLock()
+	defer ix.RUnlock()
+	if ix.wordCount[word] > 0 {
+		return ""
+	}
+	if c := closest(word, ix.wordCount); len(c) > 0 {
+		return c[0]
+	}
+	return ""
+}
+
+// suggestQuery builds a "did you mean" query by swapping unknown
+// words and tags in the query for the closest known ones.  It
+// returns the empty string when there is nothing to correct.
+func suggestQuery(q string, n queryNode) string {
+	out := q
+	changed := false
+	walkQuery(n, func(n queryNode) {
+		switch n := n.(type) {
+		case *textNode:
+			if n.prefix {
+				return
+			}
+			for _, w := range tokenize(n.text) {
+				if s := index.suggest(w); s != "" {
+					out = replaceWord(out, w, s)
+					changed = true
+				}
+			}
+		case *tagNode:
+			if alts := index.tagAlternatives(n.tag); len(alts) > 1 {
+				out = replaceWord(out, n.tag, alts[1])
+				changed = true
+			}
+		}
+	})
+	if !changed {
+		return ""
+	}
+	return out
+}
+
+// replaceWord replaces the first case insensitive occurrence of old
+// in s that isn't part of a longer word.
+func replaceWord(s, old, repl string) string {
+	ls, lo := strings.ToLower(s), strings.ToLower(old)
+	for off := 0; off < len(ls); {
+		i := strings.Index(ls[off:], lo)
+		if i < 0 {
+			break
+		}
+		i += off
+		end := i + len(lo)
+		if (i == 0 || !isWordByte(ls[i-1])) && (end == len(ls) || !isWordByte(ls[end])) {
+			return s[:i] + repl + s[end:]
+		}
+		off = end
+	}
+	return s
+}
+
+func isWordByte(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= '0' && b <= '9' || b >= 0x80
+}
+
+// walkQuery calls fn for every node of the query.
+func walkQuery(n queryNode, fn func(queryNode)) {
+	fn(n)
+	switch n := n.(type) {
+	case *andNode:
+		walkQuery(n.left, fn)
+		walkQuery(n.right, fn)
+	case *orNode:
+		walkQuery(n.left, fn)
+		walkQuery(n.right, fn)
+	case *notNode:
+		walkQuery(n.inner, fn)
+	}
+}
+
+// fragment is a piece of highlighted text.
+type fragment struct {
+	Text  string
+	Match bool
+}
+
+// highlight splits text into fragments, marking the words whose stem
+// is in stems.  If limit is positive the text is cut down to roughly
+// limit characters around the first match.
+func highlight(text string, stems map[string]bool, limit int) []fragment {
+	rs := []rune(text)
+	start, end := 0, len(rs)
+
+	type span struct{ from, to int }
+	var matches []span
+	for i := 0; i < len(rs); {
+		if !unicode.IsLetter(rs[i]) && !unicode.IsDigit(rs[i]) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(rs) && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j])) {
+			j++
+		}
+		if stems[stem(strings.ToLower(string(rs[i:j])))] {
+			matches = append(matches, span{i, j})
+		}
+		i = j
+	}
+
+	if limit > 0 && len(rs) > limit {
+		if len(matches) > 0 {
+			start = matches[0].from - limit/4
+			if start < 0 {
+				start = 0
+			}
+		}
+		end = start + limit
+		if end > len(rs) {
+			end = len(rs)
+		}
+	}
+
+	var frags []fragment
+	if start > 0 {
+		frags = append(frags, fragment{Text: "…"})
+	}
+	pos := start
+	for _, m := range matches {
+		if m.from < start || m.to > end {
+			continue
+		}
+		if m.from > pos {
+			frags = append(frags, fragment{Text: string(rs[pos:m.from])})
+		}
+		frags = append(frags, fragment{Text: string(rs[m.from:m.to]), Match: true})
+		pos = m.to
+	}
+	if pos < end {
+		frags = append(frags, fragment{Text: string(rs[pos:end])})
+	}
+	if end < len(rs) {
+		frags = append(frags, fragment{Text: "…"})
+	}
+	return frags
+}
+
+// queryStems returns every stem the text terms of a query matched
+// on, which is what should be highlighted in the results.
+func queryStems(n queryNode) map[string]bool {
+	stems := make(map[string]bool)
+	for _, t := range textTerms(n) {
+		for _, g := range t.stems() {
+			for _, s := range g {
+				stems[s] = true
+			}
+		}
+	}
+	return stems
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		max  int
+		want int
+	}{
+		{"", "", 2, 0},
+		{"kitten", "kitten", 2, 0},
+		{"kitten", "sitten", 2, 1},
+		{"kitten", "sitting", 2, 3},
+		{"kitten", "sitting", 3, 3},
+		{"holiday", "hoilday", 2, 1},
+		{"ca", "abc", 3, 3},
+		{"cat", "cats", 1, 1},
+		{"cat", "category", 2, 3},
+		{"café", "cafe", 1, 1},
+		{"naïve", "naive", 0, 1},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, tt.max); got != tt.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestMaxEdits(t *testing.T) {
+	tests := map[string]int{"cat": 0, "dogs": 1, "garden": 1, "holiday": 2, "café": 1}
+	for word, want := range tests {
+		if got := maxEdits(word); got != want {
+			t.Errorf("maxEdits(%q) = %d, want %d", word, got, want)
+		}
+	}
+}
+
+func TestClosest(t *testing.T) {
+	candidates := map[string]int{"holiday": 1, "holidays": 5, "holly": 9, "hollidays": 2, "hello": 3}
+	got := closest("holidy", candidates)
+	want := []string{"holiday"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("closest(holidy) = %q, want %q", got, want)
+	}
+	got = closest("hollidayz", candidates)
+	want = []string{"hollidays", "holidays", "holiday"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("closest(hollidayz) = %q, want %q", got, want)
+	}
+	if got := closest("hol", candidates); got != nil {
+		t.Errorf("closest corrected a short word: %q", got)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	defer withLibrary(
+		&LibraryEntry{Filename: "a.mp4", Title: "Garden party", Tags: []string{"family/holiday"}},
+		&LibraryEntry{Filename: "b.mp4", Title: "Garden tour"},
+	)()
+
+	tests := map[string]string{
+		"gardne": "garden",
+		"garden": "",
+		"party":  "",
+		"zzzzzz": "",
+	}
+	for word, want := range tests {
+		if got := index.suggest(word); got != want {
+			t.Errorf("suggest(%q) = %q, want %q", word, got, want)
+		}
+	}
+
+	queries := map[string]string{
+		"Gardne prty":         "garden party",
+		"gardne xq":           "garden xq",
+		"tag:family/holiady":  "tag:family/holiday",
+		"tag:family":          "",
+		"garden gard*":        "",
+		"garden -title:gardn": "",
+	}
+	for q, want := range queries {
+		n, err := parseQuery(q)
+		if err != nil {
+			t.Fatalf("parseQuery(%q): %s", q, err)
+		}
+		if got := suggestQuery(q, n); got != want {
+			t.Errorf("suggestQuery(%q) = %q, want %q", q, got, want)
+		}
+	}
+}
+
+func TestReplaceWord(t *testing.T) {
+	tests := []struct{ s, old, repl, want string }{
+		{"cat catalog", "cat", "dog", "dog catalog"},
+		{"catalog cat", "cat", "dog", "catalog dog"},
+		{"Cat", "cat", "dog", "dog"},
+		{"concat", "cat", "dog", "concat"},
+	}
+	for _, tt := range tests {
+		if got := replaceWord(tt.s, tt.old, tt.repl); got != tt.want {
+			t.Errorf("replaceWord(%q, %q, %q) = %q, want %q", tt.s, tt.old, tt.repl, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// hashSample is how much of the start, middle and end of a file goes
+// into its content hash.
+const hashSample = 4 << 20
+
+// contentHash returns a hash identifying the contents of a video.
+// Reading whole videos would take too long, so the hash covers the
+// size of the file and samples from its start, middle and end, which
+// is enough to tell videos apart and to notice a file being
+// replaced.
+func contentHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	binary.Write(h, binary.BigEndian, st.Size())
+	offsets := []int64{0}
+	if st.Size() > 3*hashSample {
+		offsets = append(offsets, st.Size()/2-hashSample/2, st.Size()-hashSample)
+	}
+	for _, off := range offsets {
+		if _, err := f.Seek(off, io.SeekStart); err != nil {
+			return "", err
+		}
+		n := int64(hashSample)
+		if len(offsets) == 1 {
+			n = st.Size()
+		}
+		if _, err := io.CopyN(h, f, n); err != nil && err != io.EOF {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashMemo is a remembered content hash, valid as long as the file
+// keeps its size and modification time.  They are kept in the
+// database so that videos aren't hashed again after a restart.
+type hashMemo struct {
+	Size     int64
+	Modified time.Time
+	Hash     string
+}
+
+var hashes = struct {
+	sync.Mutex
+	m map[string]hashMemo
+}{m: make(map[string]hashMemo)}
+
+// knownHash returns the content hash of a video in the library if it
+// has been worked out and the file hasn't changed since.  Handlers
+// use it rather than videoHash, leaving the hashing to jobs.
+func knownHash(file string) (string, bool) {
+	st, err := os.Stat(filepath.Join(*videoDir, file))
+	if err != nil {
+		return "", false
+	}
+	hashes.Lock()
+	m, ok := hashes.m[file]
+	hashes.Unlock()
+	if !ok || m.Size != st.Size() || !m.Modified.Equal(st.ModTime()) {
+		return "", false
+	}
+	return m.Hash, true
+}
+
+// videoHash returns the content hash of a video in the library,
+// hashing it again only if the file changed.
+func videoHash(file string) (string, error) {
+	if h, ok := knownHash(file); ok {
+		return h, nil
+	}
+	path := filepath.Join(*videoDir, file)
+	st, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	h, err := contentHash(path)
+	if err != nil {
+		return "", err
+	}
+	hashes.Lock()
+	hashes.m[file] = hashMemo{Size: st.Size(), Modified: st.ModTime(), Hash: h}
+	hashes.Unlock()
+	return h, nil
+}
+
+// hashSnapshot returns the remembered hashes, to be saved in the
+// database.
+func hashSnapshot() map[string]hashMemo {
+	hashes.Lock()
+	defer hashes.Unlock()
+	out := make(map[string]hashMemo, len(hashes.m))
+	for k, v := range hashes.m {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// hlsStub is the -hls_cmd value selecting the built in packager,
+// which doesn't segment anything but gives a playlist with the whole
+// file copied as its only segment.mp4.  That isn't adaptive streaming
+// and nothing is saved over playing the file directly: it is only
+// for testing the streaming code without a real packager installed.
+const hlsStub = "stub"
+
+// hlsStubDuration is the length given for the segment of the built
+// in packager, which doesn't know how long videos are.
+const hlsStubDuration = 3600
+
+// hlsPlaylist is the name of the playlist of a packaged video.
+const hlsPlaylist = "index.m3u8"
+
+// hlsTypes are the content types of the files of a packaged video.
+var hlsTypes = map[string]string{
+	".m3u8": "application/vnd.apple.mpegurl",
+	".ts":   "video/mp2t",
+	".m4s":  "video/iso.segment",
+	".mp4":  "video/mp4",
+}
+
+var hlsName = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+func hlsEnabled() bool {
+	return *hlsCmd != ""
+}
+
+// hlsDir returns where the packaged video with a content hash is
+// cached.  The playlist is only there once packaging is complete.
+func hlsDir(hash string) string {
+	return filepath.Join(*cacheDir, "hls", hash)
+}
+
+// hlsURL returns the address of the playlist of a packaged video.
+func hlsURL(hash string) string {
+	return "/hls/" + hash + "/" + hlsPlaylist
+}
+
+// hlsJob packages a video for HTTP Live Streaming.  The command gets
+// {input}, the directory {dir} to write to and the {playlist} to
+// write, which the player is pointed at.
+func hlsJob(ctx context.Context, j *Job) error {
+	hash, err := videoHash(j.File)
+	if err != nil {
+		return err
+	}
+	dir := hlsDir(hash)
+	if _, err := os.Stat(filepath.Join(dir, hlsPlaylist)); err == nil {
+		return nil
+	}
+
+	// Packaging happens in a directory of its own, which replaces
+	// the cached one when complete.
+	tmp := dir + ".tmp"
+	os.RemoveAll(tmp)
+	if err := os.MkdirAll(tmp, 0755); err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	video := filepath.Join(*videoDir, j.File)
+	if *hlsCmd == hlsStub {
+		err = stubPackage(video, tmp)
+	} else {
+		err = runCommand(ctx, "HLS", *hlsCmd, map[string]string{
+			"input":    video,
+			"dir":      tmp,
+			"playlist": filepath.Join(tmp, hlsPlaylist),
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(tmp, hlsPlaylist)); err != nil {
+		return permanent(fmt.Errorf("HLS command wrote no %s", hlsPlaylist))
+	}
+
+	os.RemoveAll(dir)
+	if err := os.Rename(tmp, dir); err != nil {
+		return err
+	}
+	size, _ := dirSize(dir)
+	j.logf("Packaged into %d MB", size>>20)
+	evictHLS(hash)
+	return nil
+}
+
+// stubPackage writes a playlist whose only segment is the video.
+func stubPackage(video, dir string) error {
+	seg := "segment" + strings.ToLower(filepath.Ext(video))
+	if err := os.Link(video, filepath.Join(dir, seg)); err != nil {
+		if err := copyFile(video, filepath.Join(dir, seg)); err != nil {
+			return err
+		}
+	}
+	playlist := fmt.Sprintf("#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-PLAYLIST-TYPE:VOD\n"+
+		"#EXT-X-TARGETDURATION:%d\n#EXTINF:%d.0,\n%s\n#EXT-X-ENDLIST\n",
+		hlsStubDuration, hlsStubDuration, seg)
+	return ioutil.WriteFile(filepath.Join(dir, hlsPlaylist), []byte(playlist), 0644)
+}
+
+func copyFile(from, to string) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(to)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// evictHLS removes the packaged videos played least recently until
+// the cache fits in -hls_cache_mb, keeping the one just made.
+// Playing a video touches its directory, so the modification times
+// tell which were played last.
+func evictHLS(keep string) {
+	root := filepath.Join(*cacheDir, "hls")
+	infos, err := ioutil.ReadDir(root)
+	if err != nil {
+		log.Printf("Could not list the HLS cache: %s", err)
+		return
+	}
+	type cached struct {
+		name string
+		used time.Time
+		size int64
+	}
+	var all []cached
+	var total int64
+	for _, fi := range infos {
+		if !fi.IsDir() || !hlsName.MatchString(fi.Name()) {
+			continue
+		}
+		size, err := dirSize(filepath.Join(root, fi.Name()))
+		if err != nil {
+			continue
+		}
+		all = append(all, cached{fi.Name(), fi.ModTime(), size})
+		total += size
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].used.Before(all[j].used) })
+
+	limit := *hlsCacheSize << 20
+	for _, c := range all {
+		if total <= limit {
+			break
+		}
+		if c.name == keep {
+			continue
+		}
+		log.Printf("Evicting %s from the HLS cache", c.name)
+		if err := os.RemoveAll(filepath.Join(root, c.name)); err != nil {
+			log.Printf("Could not evict %s: %s", c.name, err)
+			continue
+		}
+		total -= c.size
+	}
+}
+
+// hlsHandler tells where the stream of a video is, queueing a job to
+// package it if it isn't cached yet.
+func hlsHandler(w http.ResponseWriter, r *http.Request) {
+	file := r.FormValue("file")
+	libraryLock.RLock()
+	e := library[file]
+	libraryLock.RUnlock()
+	if e == nil {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+	if !hlsEnabled() {
+		http.Error(w, "HLS packaging is not configured", http.StatusNotFound)
+		return
+	}
+
+	s := struct {
+		URL string `json:",omitempty"`
+		Job *Job   `json:",omitempty"`
+	}{}
+	if hash, ok := knownHash(file); ok {
+		if _, err := os.Stat(filepath.Join(hlsDir(hash), hlsPlaylist)); err == nil {
+			s.URL = hlsURL(hash)
+		}
+	}
+	status := http.StatusOK
+	if s.URL == "" {
+		j := jobs.add("hls", file, nil)
+		s.Job = &j
+		status = http.StatusAccepted
+	}
+
+	if !wantJSON(r) {
+		if s.URL != "" {
+			http.Redirect(w, r, s.URL, http.StatusFound)
+		} else {
+			http.Error(w, "the stream is being packaged", http.StatusNotFound)
+		}
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(s); err != nil {
+		log.Printf("hlsHandler: encode error: %s", err)
+	}
+}
+
+// hlsFileHandler serves the playlists and segments of packaged
+// videos, at /hls/HASH/NAME.
+func hlsFileHandler(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/hls/"), "/")
+	if len(parts) != 2 || !hlsName.MatchString(parts[0]) || parts[1] == "" || strings.HasPrefix(parts[1], ".") {
+		http.NotFound(w, r)
+		return
+	}
+	dir := hlsDir(parts[0])
+	path := filepath.Join(dir, parts[1])
+	if _, err := os.Stat(path); err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	if parts[1] == hlsPlaylist {
+		now := time.Now()
+		os.Chtimes(dir, now, now)
+	}
+	if t, ok := hlsTypes[strings.ToLower(filepath.Ext(path))]; ok {
+		w.Header().Set("Content-Type", t)
+	}
+	http.ServeFile(w, r, path)
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withHLSStub points the video and cache directories at temporary
+// ones, with a.mp4 in the library, and packages with the built in
+// stub.  It returns a function undoing it.
+func withHLSStub(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "hls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	videos, cache := filepath.Join(dir, "videos"), filepath.Join(dir, "cache")
+	os.Mkdir(videos, 0755)
+	os.Mkdir(cache, 0755)
+	if err := ioutil.WriteFile(filepath.Join(videos, "a.mp4"), []byte("not really a video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	oldVideos, oldCache, oldCmd, oldSize := *videoDir, *cacheDir, *hlsCmd, *hlsCacheSize
+	*videoDir, *cacheDir, *hlsCmd = videos, cache, hlsStub
+	hashes.Lock()
+	oldHashes := hashes.m
+	hashes.m = make(map[string]hashMemo)
+	hashes.Unlock()
+	restoreLibrary := withLibrary(&LibraryEntry{Filename: "a.mp4"})
+	return func() {
+		restoreLibrary()
+		hashes.Lock()
+		hashes.m = oldHashes
+		hashes.Unlock()
+		*videoDir, *cacheDir, *hlsCmd, *hlsCacheSize = oldVideos, oldCache, oldCmd, oldSize
+		os.RemoveAll(dir)
+	}
+}
+
+func TestHLSStub(t *testing.T) {
+	defer withHLSStub(t)()
+
+	if err := hlsJob(context.Background(), &Job{Kind: "hls", File: "a.mp4"}); err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/hls?file=a.mp4", nil)
+	r.Header.Set("Accept", "application/json")
+	hlsHandler(w, r)
+	var s struct {
+		URL string
+		Job *Job
+	}
+	if err := json.NewDecoder(w.Body).Decode(&s); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != 200 || s.URL == "" || s.Job != nil {
+		t.Fatalf("status %d, %+v; want the playlist", w.Code, s)
+	}
+
+	w = httptest.NewRecorder()
+	hlsFileHandler(w, httptest.NewRequest("GET", s.URL, nil))
+	playlist := w.Body.String()
+	if w.Header().Get("Content-Type") != hlsTypes[".m3u8"] || !strings.Contains(playlist, "\nsegment.mp4\n") {
+		t.Fatalf("playlist %s %q", w.Header().Get("Content-Type"), playlist)
+	}
+	w = httptest.NewRecorder()
+	hlsFileHandler(w, httptest.NewRequest("GET", strings.Replace(s.URL, hlsPlaylist, "segment.mp4", 1), nil))
+	if w.Body.String() != "not really a video" {
+		t.Errorf("segment %q, want the whole video", w.Body.String())
+	}
+
+	for _, path := range []string{"/hls/../index.m3u8", "/hls/" + strings.Repeat("0", 64) + "/" + hlsPlaylist, "/hls/x/y/z"} {
+		w = httptest.NewRecorder()
+		hlsFileHandler(w, httptest.NewRequest("GET", path, nil))
+		if w.Code != 404 {
+			t.Errorf("%s: status %d, want 404", path, w.Code)
+		}
+	}
+}
+
+func TestHLSQueuesPackaging(t *testing.T) {
+	defer withHLSStub(t)()
+	old := jobs
+	defer func() { jobs = old }()
+	jobs = newJobQueue()
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/hls?file=a.mp4", nil)
+	r.Header.Set("Accept", "application/json")
+	hlsHandler(w, r)
+	if w.Code != 202 || !jobs.active("hls", "a.mp4") {
+		t.Errorf("status %d, want a packaging job queued", w.Code)
+	}
+}
+
+func TestEvictHLS(t *testing.T) {
+	defer withHLSStub(t)()
+	*hlsCacheSize = 1
+
+	root := filepath.Join(*cacheDir, "hls")
+	names := []string{strings.Repeat("a", 64), strings.Repeat("b", 64), strings.Repeat("c", 64)}
+	for i, name := range names {
+		dir := filepath.Join(root, name)
+		os.MkdirAll(dir, 0755)
+		if err := ioutil.WriteFile(filepath.Join(dir, "segment.ts"), make([]byte, 400<<10), 0644); err != nil {
+			t.Fatal(err)
+		}
+		used := time.Now().Add(time.Duration(i-10) * time.Minute)
+		os.Chtimes(dir, used, used)
+	}
+
+	// The oldest is kept since it was just packaged, so the next
+	// oldest goes.
+	evictHLS(names[0])
+	for i, want := range []bool{true, false, true} {
+		if _, err := os.Stat(filepath.Join(root, names[i])); (err == nil) != want {
+			t.Errorf("%s kept: %v, want %v", names[i][:1], err == nil, want)
+		}
+	}
+}
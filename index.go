@@ -0,0 +1,271 @@
+package main
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// BM25 tuning parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// titleWeight is how many times title words are counted, which
+	// ranks title matches above matches in the description.
+	titleWeight = 2
+)
+
+// textIndex is an inverted index over the text of the library.
+// Terms are stemmed words, and for each term the index records how
+// often it appears in each entry.
+type textIndex struct {
+	sync.RWMutex
+
+	postings map[string]map[string]int
+	docTerms map[string]map[string]int
+	docWords map[string]map[string]int
+	docLen   map[string]int
+	totalLen int
+
+	// words maps every word seen to its stem.  It lets prefix
+	// searches work on what people actually typed rather than on
+	// the stems, and is kept sorted in vocab for prefix lookups.
+	words      map[string]string
+	wordCount  map[string]int
+	vocab      []string
+	vocabDirty bool
+
+	// tags counts the entries carrying each lower cased tag, which
+	// is the vocabulary for correcting tag searches.
+	tags    map[string]int
+	docTags map[string][]string
+}
+
+var index = newTextIndex()
+
+func newTextIndex() *textIndex {
+	return &textIndex{
+		postings:  make(map[string]map[string]int),
+		docTerms:  make(map[string]map[string]int),
+		docWords:  make(map[string]map[string]int),
+		docLen:    make(map[string]int),
+		words:     make(map[string]string),
+		wordCount: make(map[string]int),
+		tags:      make(map[string]int),
+		docTags:   make(map[string][]string),
+	}
+}
+
+// tokenize splits text into lower case words.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// entryText returns the words of an entry, with the title words
+// repeated to weight them.
+func entryText(e *LibraryEntry) []string {
+	var words []string
+	for i := 0; i < titleWeight; i++ {
+		words = append(words, tokenize(e.Title)...)
+	}
+	words = append(words, tokenize(e.Description)...)
+	words = append(words, tokenize(strings.Join(e.Tags, " "))...)
+	words = append(words, tokenize(e.Filename)...)
+	words = append(words, tokenize(strings.Join(customFields.text(e), " "))...)
+	words = append(words, subtitleText(e)...)
+	for _, a := range e.Annotations {
+		words = append(words, tokenize(a.Label+" "+a.Note+" "+strings.Join(a.Tags, " "))...)
+	}
+	return words
+}
+
+// rebuild replaces the contents of the index with the library.
+func (ix *textIndex) rebuild(lib map[string]*LibraryEntry) {
+	fresh := newTextIndex()
+	for _, e := range lib {
+		fresh.add(e)
+	}
+
+	ix.Lock()
+	defer ix.Unlock()
+	ix.postings = fresh.postings
+	ix.docTerms = fresh.docTerms
+	ix.docWords = fresh.docWords
+	ix.docLen = fresh.docLen
+	ix.totalLen = fresh.totalLen
+	ix.words = fresh.words
+	ix.wordCount = fresh.wordCount
+	ix.tags = fresh.tags
+	ix.docTags = fresh.docTags
+	ix.vocabDirty = true
+}
+
+// update (re)indexes a single entry.
+func (ix *textIndex) update(e *LibraryEntry) {
+	ix.Lock()
+	defer ix.Unlock()
+	ix.remove(e.Filename)
+	ix.add(e)
+}
+
+// drop removes an entry from the index.
+func (ix *textIndex) drop(file string) {
+	ix.Lock()
+	defer ix.Unlock()
+	ix.remove(file)
+}
+
+// add indexes an entry, the caller must hold the lock.
+func (ix *textIndex) add(e *LibraryEntry) {
+	terms := make(map[string]int)
+	words := make(map[string]int)
+	text := entryText(e)
+	for _, w := range text {
+		s := stem(w)
+		terms[s]++
+		words[w]++
+		if _, ok := ix.words[w]; !ok {
+			ix.words[w] = s
+			ix.vocabDirty = true
+		}
+		ix.wordCount[w]++
+	}
+	for t, n := range terms {
+		if ix.postings[t] == nil {
+			ix.postings[t] = make(map[string]int)
+		}
+		ix.postings[t][e.Filename] = n
+	}
+	ix.docTerms[e.Filename] = terms
+	ix.docWords[e.Filename] = words
+	ix.docLen[e.Filename] = len(text)
+	ix.totalLen += len(text)
+
+	seen := make(map[string]bool)
+	for _, t := range cleanTags(e.allTags()) {
+		t = strings.ToLower(t)
+		if !seen[t] {
+			seen[t] = true
+			ix.tags[t]++
+			ix.docTags[e.Filename] = append(ix.docTags[e.Filename], t)
+		}
+	}
+}
+
+// remove drops an entry from the index, the caller must hold the
+// lock.
+func (ix *textIndex) remove(file string) {
+	for t := range ix.docTerms[file] {
+		if p := ix.postings[t]; p != nil {
+			delete(p, file)
+			if len(p) == 0 {
+				delete(ix.postings, t)
+			}
+		}
+	}
+	for w, n := range ix.docWords[file] {
+		ix.wordCount[w] -= n
+		if ix.wordCount[w] <= 0 {
+			delete(ix.wordCount, w)
+			delete(ix.words, w)
+			ix.vocabDirty = true
+		}
+	}
+	for _, t := range ix.docTags[file] {
+		if ix.tags[t]--; ix.tags[t] <= 0 {
+			delete(ix.tags, t)
+		}
+	}
+	ix.totalLen -= ix.docLen[file]
+	delete(ix.docTags, file)
+	delete(ix.docTerms, file)
+	delete(ix.docWords, file)
+	delete(ix.docLen, file)
+}
+
+// sortedVocab returns the sorted list of known words, the caller
+// must hold the write lock.
+func (ix *textIndex) sortedVocab() []string {
+	if ix.vocabDirty {
+		ix.vocab = ix.vocab[:0]
+		for w := range ix.words {
+			ix.vocab = append(ix.vocab, w)
+		}
+		sort.Strings(ix.vocab)
+		ix.vocabDirty = false
+	}
+	return ix.vocab
+}
+
+// expand turns search text into the stems it should match, one
+// group per word.  With prefix set the last word matches every known
+// word starting with it.  Words that aren't in the vocabulary also
+// match the known words they are a typo away from.
+func (ix *textIndex) expand(text string, prefix bool) [][]string {
+	words := tokenize(text)
+	groups := make([][]string, 0, len(words))
+	for i, w := range words {
+		if !prefix || i != len(words)-1 {
+			groups = append(groups, append([]string{stem(w)}, ix.fuzzyStems(w)...))
+			continue
+		}
+
+		ix.Lock()
+		vocab := ix.sortedVocab()
+		seen := make(map[string]bool)
+		var stems []string
+		for j := sort.SearchStrings(vocab, w); j < len(vocab) && strings.HasPrefix(vocab[j], w); j++ {
+			s := ix.words[vocab[j]]
+			if !seen[s] {
+				seen[s] = true
+				stems = append(stems, s)
+			}
+		}
+		ix.Unlock()
+		groups = append(groups, stems)
+	}
+	return groups
+}
+
+// contains reports if the entry contains any of the stems.
+func (ix *textIndex) contains(file string, stems []string) bool {
+	ix.RLock()
+	defer ix.RUnlock()
+	for _, s := range stems {
+		if ix.postings[s][file] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// score computes the BM25 relevance of every entry containing any
+// of the stems.
+func (ix *textIndex) score(stems []string) map[string]float64 {
+	ix.RLock()
+	defer ix.RUnlock()
+
+	scores := make(map[string]float64)
+	n := float64(len(ix.docLen))
+	if n == 0 {
+		return scores
+	}
+	avgLen := float64(ix.totalLen) / n
+
+	for _, s := range stems {
+		p := ix.postings[s]
+		df := float64(len(p))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for file, tf := range p {
+			f := float64(tf)
+			norm := 1 - bm25B + bm25B*float64(ix.docLen[file])/avgLen
+			scores[file] += idf * f * (bm25K1 + 1) / (f + bm25K1*norm)
+		}
+	}
+	return scores
+}
@@ -0,0 +1,528 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Job states.  Pending jobs wait for a worker, or for their next try
+// after failing.
+const (
+	jobPending  = "pending"
+	jobRunning  = "running"
+	jobDone     = "done"
+	jobFailed   = "failed"
+	jobCanceled = "canceled"
+)
+
+const (
+	// maxJobAttempts is how often a job is tried before it fails.
+	maxJobAttempts = 5
+
+	// jobRetryDelay is how long a job waits after its first
+	// failure, doubling with each further one up to maxJobRetryDelay.
+	jobRetryDelay    = 30 * time.Second
+	maxJobRetryDelay = time.Hour
+
+	// maxFinishedJobs is how many finished jobs are kept for the
+	// jobs page.
+	maxFinishedJobs = 200
+)
+
+// Job is a piece of slow media work done in the background, such as
+// making a thumbnail.  Kind names the work, File the entry it is for
+// and Args anything else the kind needs.
+type Job struct {
+	ID       int
+	Kind     string
+	File     string
+	Args     map[string]string `json:",omitempty"`
+	Status   string
+	Attempts int
+	Error    string   `json:",omitempty"`
+	Log      []string `json:",omitempty"`
+
+	Created  time.Time
+	Started  time.Time
+	Finished time.Time
+	NextTry  time.Time
+
+	cancel context.CancelFunc
+	key    string
+}
+
+// permanentError is an error that trying again won't fix, such as a
+// missing thumbnail command.
+type permanentError struct {
+	error
+}
+
+func permanent(err error) error {
+	return permanentError{err}
+}
+
+// jobKinds maps the kinds of jobs to the functions doing them.  They
+// should give up when ctx is canceled.
+var jobKinds = map[string]func(ctx context.Context, j *Job) error{
+	"probe":      probeJob,
+	"thumbnail":  thumbnailJob,
+	"storyboard": storyboardJob,
+	"hls":        hlsJob,
+	"proxy":      proxyJob,
+	"clip":       clipJob,
+	"waveform":   waveformJob,
+}
+
+// jobQueue holds the jobs, in the order they were queued, and runs
+// them on at most -workers goroutines.  Scanning queues a few jobs
+// for every file, so jobs are also indexed by ID and by what they
+// do.
+type jobQueue struct {
+	sync.Mutex
+	jobs     []*Job
+	byID     map[int]*Job
+	byKey    map[string]*Job
+	finished int
+	nextID   int
+	running  int
+	dirty    bool
+	wake     chan struct{}
+}
+
+var jobs = newJobQueue()
+
+func newJobQueue() *jobQueue {
+	return &jobQueue{
+		byID:   make(map[int]*Job),
+		byKey:  make(map[string]*Job),
+		nextID: 1,
+		wake:   make(chan struct{}, 1),
+	}
+}
+
+// jobKey identifies the work a job does, so that the same work isn't
+// queued twice.
+func jobKey(kind, file string, args map[string]string) string {
+	keys := make([]string, 0, len(args))
+	for k := range args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := []string{kind, file}
+	for _, k := range keys {
+		parts = append(parts, k+"="+args[k])
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// changedSince reports whether a file was modified after t.
+func changedSince(file string, t time.Time) bool {
+	st, err := os.Stat(filepath.Join(*videoDir, file))
+	return err == nil && st.ModTime().After(t)
+}
+
+// add queues a job and returns a copy of it.  A job that is already
+// queued or running is returned instead of adding it again, as is
+// one that failed for good, unless the file changed since.
+func (q *jobQueue) add(kind, file string, args map[string]string) Job {
+	q.Lock()
+	defer q.Unlock()
+	key := jobKey(kind, file, args)
+	if j := q.byKey[key]; j != nil {
+		switch {
+		case j.Status == jobPending || j.Status == jobRunning:
+			return *j
+		case j.Status == jobFailed && !changedSince(file, j.Finished):
+			return *j
+		}
+	}
+	return *q.queue(kind, file, args)
+}
+
+// queue adds a new job.  The queue must be locked.
+func (q *jobQueue) queue(kind, file string, args map[string]string) *Job {
+	j := &Job{
+		ID:      q.nextID,
+		Kind:    kind,
+		File:    file,
+		Args:    args,
+		Status:  jobPending,
+		Created: time.Now(),
+		key:     jobKey(kind, file, args),
+	}
+	q.nextID++
+	q.jobs = append(q.jobs, j)
+	q.byID[j.ID] = j
+	q.byKey[j.key] = j
+	q.dirty = true
+	q.signal()
+	return j
+}
+
+// retry queues the work of a failed or canceled job again.
+func (q *jobQueue) retry(id int) (Job, error) {
+	q.Lock()
+	defer q.Unlock()
+	j := q.byID[id]
+	if j == nil {
+		return Job{}, fmt.Errorf("no job %d", id)
+	}
+	if j.Status != jobFailed && j.Status != jobCanceled {
+		return Job{}, fmt.Errorf("job %d is %s", id, j.Status)
+	}
+	if other := q.byKey[j.key]; other != nil && other != j {
+		// The work was queued again since.
+		return *other, nil
+	}
+	n := q.queue(j.Kind, j.File, j.Args)
+	q.logf(j, "Retried as job %d", n.ID)
+	return *n, nil
+}
+
+func (q *jobQueue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// active reports whether a job of a kind without arguments is
+// waiting or running for a file.
+func (q *jobQueue) active(kind, file string) bool {
+	q.Lock()
+	defer q.Unlock()
+	j := q.byKey[jobKey(kind, file, nil)]
+	return j != nil && (j.Status == jobPending || j.Status == jobRunning)
+}
+
+// list returns copies of the jobs, newest first.
+func (q *jobQueue) list() []Job {
+	q.Lock()
+	defer q.Unlock()
+	out := make([]Job, len(q.jobs))
+	for i, j := range q.jobs {
+		out[len(q.jobs)-1-i] = *j
+	}
+	return out
+}
+
+func (q *jobQueue) get(id int) (Job, bool) {
+	q.Lock()
+	defer q.Unlock()
+	if j := q.byID[id]; j != nil {
+		return *j, true
+	}
+	return Job{}, false
+}
+
+// cancel stops a job, interrupting it if it is running.
+func (q *jobQueue) cancel(id int) error {
+	q.Lock()
+	defer q.Unlock()
+	j := q.byID[id]
+	if j == nil {
+		return fmt.Errorf("no job %d", id)
+	}
+	switch j.Status {
+	case jobRunning:
+		j.cancel()
+	case jobPending:
+		j.Finished = time.Now()
+		q.finished++
+	default:
+		return fmt.Errorf("job %d is already %s", id, j.Status)
+	}
+	j.Status = jobCanceled
+	q.logf(j, "Canceled")
+	q.dirty = true
+	return nil
+}
+
+// logf adds a line to the log of a job.  The queue must be locked.
+func (q *jobQueue) logf(j *Job, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	j.Log = append(j.Log, time.Now().Format("15:04:05 ")+msg)
+	log.Printf("Job %d (%s %s): %s", j.ID, j.Kind, j.File, msg)
+}
+
+// logf adds a line to the log of a job while it runs.
+func (j *Job) logf(format string, args ...interface{}) {
+	jobs.Lock()
+	defer jobs.Unlock()
+	jobs.logf(j, format, args...)
+}
+
+// run starts jobs as workers become free and their retry times come,
+// forever.
+func (q *jobQueue) run() {
+	for {
+		q.Lock()
+		now := time.Now()
+		wait := time.Duration(-1)
+		for _, j := range q.jobs {
+			if q.running >= *workers {
+				break
+			}
+			if j.Status != jobPending {
+				continue
+			}
+			if d := j.NextTry.Sub(now); d > 0 {
+				if wait < 0 || d < wait {
+					wait = d
+				}
+				continue
+			}
+			q.start(j)
+		}
+		q.Unlock()
+
+		if wait < 0 {
+			<-q.wake
+		} else {
+			select {
+			case <-q.wake:
+			case <-time.After(wait):
+			}
+		}
+	}
+}
+
+// start runs a job on a new worker.  The queue must be locked.
+func (q *jobQueue) start(j *Job) {
+	ctx, cancel := context.WithCancel(context.Background())
+	j.cancel = cancel
+	j.Status = jobRunning
+	j.Attempts++
+	j.Started = time.Now()
+	q.running++
+	q.logf(j, "Started, attempt %d", j.Attempts)
+
+	fn := jobKinds[j.Kind]
+	go func() {
+		var err error
+		if fn == nil {
+			err = permanent(fmt.Errorf("unknown kind of job %q", j.Kind))
+		} else {
+			err = fn(ctx, j)
+		}
+		cancel()
+		q.finish(j, err)
+	}()
+}
+
+// finish records how a job went, queueing it to be tried again later
+// if it failed and may still work.
+func (q *jobQueue) finish(j *Job, err error) {
+	q.Lock()
+	defer q.Unlock()
+	q.running--
+	q.dirty = true
+	j.cancel = nil
+	defer q.signal()
+
+	if j.Status == jobCanceled {
+		j.Finished = time.Now()
+		q.finished++
+		return
+	}
+	if err == nil {
+		j.Status = jobDone
+		j.Error = ""
+		j.Finished = time.Now()
+		q.logf(j, "Done")
+		q.finished++
+		q.trim()
+		return
+	}
+
+	j.Error = err.Error()
+	_, isPermanent := err.(permanentError)
+	if isPermanent || j.Attempts >= maxJobAttempts {
+		j.Status = jobFailed
+		j.Finished = time.Now()
+		q.logf(j, "Failed: %s", err)
+		q.finished++
+		q.trim()
+		return
+	}
+	delay := jobRetryDelay << uint(j.Attempts-1)
+	if delay > maxJobRetryDelay {
+		delay = maxJobRetryDelay
+	}
+	j.Status = jobPending
+	j.NextTry = time.Now().Add(delay)
+	q.logf(j, "Failed, trying again in %s: %s", delay, err)
+}
+
+// trim forgets the oldest finished jobs.  Trimming goes through all
+// the jobs, so it waits until twice as many as are kept have
+// finished.  The queue must be locked.
+func (q *jobQueue) trim() {
+	if q.finished <= 2*maxFinishedJobs {
+		return
+	}
+	kept := q.jobs[:0]
+	for _, j := range q.jobs {
+		if q.finished > maxFinishedJobs && j.Status != jobPending && j.Status != jobRunning {
+			q.finished--
+			delete(q.byID, j.ID)
+			if q.byKey[j.key] == j {
+				delete(q.byKey, j.key)
+			}
+			continue
+		}
+		kept = append(kept, j)
+	}
+	for i := len(kept); i < len(q.jobs); i++ {
+		q.jobs[i] = nil
+	}
+	q.jobs = kept
+}
+
+// changed reports whether the jobs changed since the last snapshot.
+func (q *jobQueue) changed() bool {
+	q.Lock()
+	defer q.Unlock()
+	return q.dirty
+}
+
+// snapshot returns the unfinished jobs, to be saved in the database.
+// Running jobs are saved as pending so that they start over after a
+// restart.
+func (q *jobQueue) snapshot() []*Job {
+	q.Lock()
+	defer q.Unlock()
+	q.dirty = false
+	var out []*Job
+	for _, j := range q.jobs {
+		if j.Status == jobPending || j.Status == jobRunning {
+			c := *j
+			c.Status = jobPending
+			c.cancel = nil
+			out = append(out, &c)
+		}
+	}
+	return out
+}
+
+// load queues the jobs saved in the database.
+func (q *jobQueue) load(saved []*Job) {
+	q.Lock()
+	defer q.Unlock()
+	for _, j := range saved {
+		j.Status = jobPending
+		j.key = jobKey(j.Kind, j.File, j.Args)
+		q.jobs = append(q.jobs, j)
+		q.byID[j.ID] = j
+		q.byKey[j.key] = j
+		if j.ID >= q.nextID {
+			q.nextID = j.ID + 1
+		}
+	}
+	if len(saved) > 0 {
+		log.Printf("Resuming %d jobs", len(saved))
+		q.signal()
+	}
+}
+
+// jobsHandler shows the jobs, or with id a single one.
+func jobsHandler(w http.ResponseWriter, r *http.Request) {
+	var list []Job
+	if id := r.FormValue("id"); id != "" {
+		n, _ := strconv.Atoi(id)
+		j, ok := jobs.get(n)
+		if !ok {
+			http.Error(w, "no such job", http.StatusNotFound)
+			return
+		}
+		list = []Job{j}
+	} else {
+		list = jobs.list()
+	}
+
+	if wantJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		var v interface{} = list
+		if r.FormValue("id") != "" {
+			v = list[0]
+		}
+		if err := json.NewEncoder(w).Encode(v); err != nil {
+			log.Printf("jobsHandler: encode error: %s", err)
+		}
+		return
+	}
+
+	s := struct {
+		Jobs    []Job
+		Workers int
+		Active  bool
+	}{Jobs: list, Workers: *workers}
+	for _, j := range list {
+		if j.Status == jobPending || j.Status == jobRunning {
+			s.Active = true
+		}
+	}
+	err := jobsTmpl.ExecuteTemplate(w, "layout", s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// jobRetryHandler queues the work of the failed or canceled job given
+// by id again.
+func jobRetryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "retrying a job requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		http.Error(w, "bad job id", http.StatusBadRequest)
+		return
+	}
+	j, err := jobs.retry(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if wantJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(j)
+		return
+	}
+	http.Redirect(w, r, "/jobs", http.StatusSeeOther)
+}
+
+// jobCancelHandler cancels the job given by id.
+func jobCancelHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "canceling a job requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		http.Error(w, "bad job id", http.StatusBadRequest)
+		return
+	}
+	if err := jobs.cancel(id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if wantJSON(r) {
+		j, _ := jobs.get(id)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(j)
+		return
+	}
+	http.Redirect(w, r, "/jobs", http.StatusSeeOther)
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// finishJob starts a job and finishes it with err as a worker would.
+func finishJob(q *jobQueue, j *Job, err error) {
+	q.Lock()
+	j.Status = jobRunning
+	j.Attempts++
+	q.running++
+	q.Unlock()
+	q.finish(j, err)
+}
+
+func TestJobQueueAddDedupes(t *testing.T) {
+	q := newJobQueue()
+	a := q.add("thumbnail", "a.mp4", nil)
+	if b := q.add("thumbnail", "a.mp4", nil); b.ID != a.ID {
+		t.Errorf("queued the same work twice: %d and %d", a.ID, b.ID)
+	}
+	if b := q.add("thumbnail", "a.mp4", map[string]string{"t": "1.000"}); b.ID == a.ID {
+		t.Error("work with other arguments was taken for the same")
+	}
+	if b := q.add("probe", "a.mp4", nil); b.ID == a.ID {
+		t.Error("work of another kind was taken for the same")
+	}
+	if !q.active("thumbnail", "a.mp4") || q.active("thumbnail", "b.mp4") {
+		t.Error("active doesn't agree with the queue")
+	}
+	if j, ok := q.get(a.ID); !ok || j.Kind != "thumbnail" {
+		t.Errorf("get(%d) = %+v, %v", a.ID, j, ok)
+	}
+}
+
+func TestJobKeyIgnoresArgOrder(t *testing.T) {
+	a := jobKey("clip", "a.mp4", map[string]string{"start": "1", "end": "2"})
+	b := jobKey("clip", "a.mp4", map[string]string{"end": "2", "start": "1"})
+	if a != b {
+		t.Errorf("%q != %q", a, b)
+	}
+	if jobKey("clip", "a.mp4", map[string]string{"start": "1=end"}) == jobKey("clip", "a.mp4", map[string]string{"start": "1", "end": ""}) {
+		t.Error("different arguments share a key")
+	}
+}
+
+func TestJobQueueFailedJobs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldDir := *videoDir
+	*videoDir = dir
+	defer func() { *videoDir = oldDir }()
+	path := filepath.Join(dir, "a.mp4")
+	if err := ioutil.WriteFile(path, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	os.Chtimes(path, old, old)
+
+	q := newJobQueue()
+	first := q.add("thumbnail", "a.mp4", nil)
+	finishJob(q, q.byID[first.ID], permanent(errors.New("broken")))
+	if j := q.add("thumbnail", "a.mp4", nil); j.ID != first.ID || j.Status != jobFailed {
+		t.Fatalf("a failed job was queued again for an unchanged file: %+v", j)
+	}
+
+	// A retry queues the work again, once.
+	retried, err := q.retry(first.ID)
+	if err != nil || retried.ID == first.ID || retried.Status != jobPending {
+		t.Fatalf("retry = %+v, %v", retried, err)
+	}
+	if again, _ := q.retry(first.ID); again.ID != retried.ID {
+		t.Errorf("retrying twice queued job %d and %d", retried.ID, again.ID)
+	}
+	if _, err := q.retry(retried.ID); err == nil {
+		t.Error("a pending job was retried")
+	}
+
+	// Once the file changes a failed job is queued again.
+	finishJob(q, q.byID[retried.ID], permanent(errors.New("still broken")))
+	now := time.Now().Add(time.Minute)
+	os.Chtimes(path, now, now)
+	if j := q.add("thumbnail", "a.mp4", nil); j.ID == retried.ID || j.Status != jobPending {
+		t.Errorf("a failed job wasn't queued again after the file changed: %+v", j)
+	}
+}
+
+func TestJobQueueTrim(t *testing.T) {
+	q := newJobQueue()
+	pending := q.add("probe", "keep.mp4", nil)
+	var ids []int
+	for i := 0; i < 3*maxFinishedJobs; i++ {
+		j := q.add("probe", fmt.Sprintf("%d.mp4", i), nil)
+		ids = append(ids, j.ID)
+		finishJob(q, q.byID[j.ID], nil)
+	}
+	if q.finished > 2*maxFinishedJobs {
+		t.Errorf("%d finished jobs kept", q.finished)
+	}
+	if _, ok := q.get(pending.ID); !ok {
+		t.Error("a pending job was trimmed")
+	}
+	if _, ok := q.get(ids[0]); ok {
+		t.Error("the oldest finished job wasn't trimmed")
+	}
+	if _, ok := q.get(ids[len(ids)-1]); !ok {
+		t.Error("the newest finished job was trimmed")
+	}
+	if len(q.byID) != len(q.jobs) {
+		t.Errorf("%d jobs indexed by ID but %d kept", len(q.byID), len(q.jobs))
+	}
+}
+
+func TestJobBackoff(t *testing.T) {
+	q := newJobQueue()
+	j := q.byID[q.add("thumbnail", "a.mp4", nil).ID]
+	want := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute}
+	for i, delay := range want {
+		before := time.Now()
+		finishJob(q, j, errors.New("busy"))
+		if j.Status != jobPending {
+			t.Fatalf("attempt %d: status %s, want pending", i+1, j.Status)
+		}
+		if d := j.NextTry.Sub(before); d < delay || d > delay+time.Second {
+			t.Errorf("attempt %d: next try in %s, want %s", i+1, d, delay)
+		}
+	}
+	finishJob(q, j, errors.New("busy"))
+	if j.Status != jobFailed || j.Error != "busy" || j.Attempts != maxJobAttempts {
+		t.Errorf("after %d attempts: %+v", maxJobAttempts, j)
+	}
+}
+
+func TestJobPermanentError(t *testing.T) {
+	q := newJobQueue()
+	j := q.byID[q.add("thumbnail", "a.mp4", nil).ID]
+	finishJob(q, j, permanent(errors.New("no thumbnail command")))
+	if j.Status != jobFailed || j.Attempts != 1 || !j.NextTry.IsZero() {
+		t.Errorf("a permanent error was retried: %+v", j)
+	}
+	if j.Error != "no thumbnail command" {
+		t.Errorf("error %q", j.Error)
+	}
+	if q.running != 0 || q.finished != 1 {
+		t.Errorf("running %d finished %d", q.running, q.finished)
+	}
+}
+
+func TestJobCancel(t *testing.T) {
+	started := make(chan bool)
+	jobKinds["test"] = func(ctx context.Context, j *Job) error {
+		started <- true
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	defer delete(jobKinds, "test")
+
+	q := newJobQueue()
+	pending := q.add("test", "a.mp4", nil)
+	running := q.add("test", "b.mp4", nil)
+	q.Lock()
+	q.start(q.byID[running.ID])
+	q.Unlock()
+	<-started
+
+	if snap := q.snapshot(); len(snap) != 2 || snap[1].Status != jobPending {
+		t.Errorf("snapshot %+v, want both jobs pending", snap)
+	}
+	for _, id := range []int{pending.ID, running.ID} {
+		if err := q.cancel(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 100; i++ {
+		if j, _ := q.get(running.ID); !j.Finished.IsZero() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, id := range []int{pending.ID, running.ID} {
+		if j, _ := q.get(id); j.Status != jobCanceled || j.Finished.IsZero() {
+			t.Errorf("job %d: %+v", id, j)
+		}
+	}
+	if err := q.cancel(pending.ID); err == nil {
+		t.Error("a canceled job was canceled again")
+	}
+	q.Lock()
+	defer q.Unlock()
+	if q.running != 0 || q.finished != 2 {
+		t.Errorf("running %d finished %d", q.running, q.finished)
+	}
+}
+
+func TestQueueMediaJobsOnlyQueuesMissingWork(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	oldVideos, oldCache := *videoDir, *cacheDir
+	*videoDir, *cacheDir = dir, filepath.Join(dir, "cache")
+	defer func() { *videoDir, *cacheDir = oldVideos, oldCache }()
+	if err := ioutil.WriteFile(filepath.Join(dir, "a.mp4"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	oldJobs := jobs
+	defer func() { jobs = oldJobs }()
+	hashes.Lock()
+	oldHashes := hashes.m
+	hashes.m = make(map[string]hashMemo)
+	hashes.Unlock()
+	defer func() {
+		hashes.Lock()
+		hashes.m = oldHashes
+		hashes.Unlock()
+	}()
+
+	e := &LibraryEntry{Filename: "a.mp4", Kind: kindVideo}
+	jobs = newJobQueue()
+	queueMediaJobs(e)
+	if !jobs.active("probe", "a.mp4") || !jobs.active("thumbnail", "a.mp4") {
+		t.Fatalf("queued %+v, want probing and a thumbnail for a new file", jobs.list())
+	}
+
+	hash, err := videoHash("a.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	thumb := thumbPath(hash, posterTime(0))
+	os.MkdirAll(filepath.Dir(thumb), 0755)
+	if err := ioutil.WriteFile(thumb, []byte("jpeg"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	e.MIME = "video/mp4"
+	jobs = newJobQueue()
+	queueMediaJobs(e)
+	if l := jobs.list(); len(l) != 0 {
+		t.Errorf("queued %+v for a file with nothing missing", l)
+	}
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+// listQuery describes a filtered, sorted and paginated view of the
+// library.  It is shared by the /videos API and the /list page so
+// that both agree on what a page of results looks like.
+type listQuery struct {
+	Sort    string
+	Desc    bool
+	Limit   int
+	Cursor  string
+	Fields  []string
+	Tags    []string
+	Missing []string
+	Kinds   []string
+	From    time.Time
+	To      time.Time
+
+	// Q is the query language expression, see query.go.
+	Q      string
+	Search queryNode
+}
+
+// listPage is a single page of results from a listQuery.
+type listPage struct {
+	Entries []*LibraryEntry
+	Total   int
+	Next    string
+}
+
+// listFields maps the selectable field names to a function that
+// extracts them from an entry.
+var listFields = map[string]func(*LibraryEntry) interface{}{
+	"Filename":    func(e *LibraryEntry) interface{} { return e.Filename },
+	"Title":       func(e *LibraryEntry) interface{} { return e.Title },
+	"Tags":        func(e *LibraryEntry) interface{} { return e.Tags },
+	"Date":        func(e *LibraryEntry) interface{} { return &e.Date },
+	"Description": func(e *LibraryEntry) interface{} { return e.Description },
+	"Modified":    func(e *LibraryEntry) interface{} { return e.Modified },
+	"Extra":       func(e *LibraryEntry) interface{} { return e.Extra },
+	"MIME":        func(e *LibraryEntry) interface{} { return e.MIME },
+	"Kind":        func(e *LibraryEntry) interface{} { return entryKind(e) },
+	"Poster":      func(e *LibraryEntry) interface{} { return e.Poster },
+}
+
+// sortKeys maps the supported sort orders to a function producing a
+// string that orders lexically the same way the field should.
+var sortKeys = map[string]func(*LibraryEntry) string{
+	"title": func(e *LibraryEntry) string {
+		if e.Title != "" {
+			return strings.ToLower(e.Title)
+		}
+		return strings.ToLower(e.Filename)
+	},
+	"date":     func(e *LibraryEntry) string { return e.Date.Format("2006-01-02") },
+	"filename": func(e *LibraryEntry) string { return e.Filename },
+	"modified": func(e *LibraryEntry) string { return e.Modified.UTC().Format("2006-01-02T15:04:05.000000000") },
+}
+
+// parseListQuery builds a listQuery from the request's form values.
+func parseListQuery(r *http.Request) (*listQuery, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	q := &listQuery{
+		Sort:    strings.ToLower(r.FormValue("sort")),
+		Desc:    r.FormValue("order") == "desc",
+		Limit:   defaultPageSize,
+		Cursor:  r.FormValue("cursor"),
+		Tags:    splitValues(r.Form["tag"]),
+		Missing: splitValues(r.Form["missing"]),
+		Kinds:   splitValues(r.Form["kind"]),
+	}
+	if _, ok := sortKeys[q.Sort]; !ok && q.Sort != "" && q.Sort != "relevance" {
+		return nil, fmt.Errorf("unknown sort key %q", q.Sort)
+	}
+
+	if l := r.FormValue("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("bad limit %q", l)
+		}
+		if n > maxPageSize {
+			n = maxPageSize
+		}
+		q.Limit = n
+	}
+
+	for _, f := range splitValues(r.Form["fields"]) {
+		if _, ok := listFields[f]; !ok {
+			return nil, fmt.Errorf("unknown field %q", f)
+		}
+		q.Fields = append(q.Fields, f)
+	}
+
+	for _, m := range q.Missing {
+		if _, ok := missingChecks[m]; !ok {
+			return nil, fmt.Errorf("unknown field for missing %q", m)
+		}
+	}
+
+	for i, k := range q.Kinds {
+		q.Kinds[i] = strings.ToLower(k)
+		if !hasKind(mediaKinds, q.Kinds[i]) {
+			return nil, fmt.Errorf("unknown kind %q", k)
+		}
+	}
+
+	var err error
+	q.Q = strings.TrimSpace(r.FormValue("q"))
+	if q.Search, err = parseQuery(q.Q); err != nil {
+		return nil, err
+	}
+
+	// Searches for text are ranked by relevance unless asked
+	// otherwise, everything else is listed by filename.
+	if q.Sort == "" {
+		q.Sort = "filename"
+		if len(textTerms(q.Search)) > 0 {
+			q.Sort = "relevance"
+		}
+	}
+
+	if q.From, err = parseFormDate(r.FormValue("from")); err != nil {
+		return nil, err
+	}
+	if q.To, err = parseFormDate(r.FormValue("to")); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// splitValues flattens repeated and comma separated form values,
+// dropping any that are empty.
+func splitValues(vals []string) []string {
+	var out []string
+	for _, v := range vals {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+func parseFormDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("bad date %q", s)
+	}
+	return t, nil
+}
+
+// missingChecks reports whether an entry lacks the named field.
+var missingChecks = map[string]func(*LibraryEntry) bool{
+	"title":       func(e *LibraryEntry) bool { return e.Title == "" },
+	"description": func(e *LibraryEntry) bool { return e.Description == "" },
+	"date":        func(e *LibraryEntry) bool { return e.Date.IsZero() },
+	"tags":        func(e *LibraryEntry) bool { return len(cleanTags(e.Tags)) == 0 },
+}
+
+// cleanTags returns the non-blank tags of an entry.  The player
+// form splits on commas, so blank tags are common in the database.
+func cleanTags(tags []string) []string {
+	var out []string
+	for _, t := range tags {
+		if t = strings.TrimSpace(t); t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// hasTag reports if the entry carries the tag or one of the tags
+// below it in the hierarchy.
+func hasTag(e *LibraryEntry, tag string) bool {
+	for _, t := range e.Tags {
+		if tagMatches(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExactTag reports if the entry carries the tag itself, ignoring
+// case but not the tags below it.
+func hasExactTag(e *LibraryEntry, tag string) bool {
+	for _, t := range e.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasKind(kinds []string, kind string) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports if the entry passes all filters in the query.
+func (q *listQuery) match(e *LibraryEntry) bool {
+	for _, t := range q.Tags {
+		if !hasTag(e, t) {
+			return false
+		}
+	}
+	for _, m := range q.Missing {
+		if !missingChecks[m](e) {
+			return false
+		}
+	}
+	if len(q.Kinds) > 0 && !hasKind(q.Kinds, entryKind(e)) {
+		return false
+	}
+	if !q.From.IsZero() && (e.Date.IsZero() || e.Date.Before(q.From)) {
+		return false
+	}
+	if !q.To.IsZero() && (e.Date.IsZero() || e.Date.After(q.To)) {
+		return false
+	}
+	return q.Search.match(e)
+}
+
+// run evaluates the query against the library.  Entries are ordered
+// by the sort key with the filename breaking ties, which keeps the
+// order stable across requests and lets the cursor simply record
+// the position of the last entry returned.
+func (q *listQuery) run(lib map[string]*LibraryEntry) (*listPage, error) {
+	key := sortKeys[q.Sort]
+	if q.Sort == "relevance" {
+		key = relevanceKey(q.Search)
+	}
+
+	type keyed struct {
+		key   string
+		entry *LibraryEntry
+	}
+	var all []keyed
+	for _, e := range lib {
+		if q.match(e) {
+			all = append(all, keyed{key(e), e})
+		}
+	}
+
+	less := func(ak, af, bk, bf string) bool {
+		if ak == bk {
+			ak, bk = af, bf
+		}
+		if q.Desc {
+			return ak > bk
+		}
+		return ak < bk
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return less(all[i].key, all[i].entry.Filename, all[j].key, all[j].entry.Filename)
+	})
+
+	start := 0
+	if q.Cursor != "" {
+		ck, cf, err := decodeCursor(q.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		start = sort.Search(len(all), func(i int) bool {
+			return less(ck, cf, all[i].key, all[i].entry.Filename)
+		})
+	}
+
+	end := start + q.Limit
+	if end > len(all) {
+		end = len(all)
+	}
+
+	page := &listPage{Entries: []*LibraryEntry{}, Total: len(all)}
+	for _, k := range all[start:end] {
+		page.Entries = append(page.Entries, k.entry)
+	}
+	if end < len(all) {
+		last := all[end-1]
+		page.Next = encodeCursor(last.key, last.entry.Filename)
+	}
+	return page, nil
+}
+
+// relevanceKey ranks entries by their BM25 score for the text terms
+// of the query.  Scores are turned into keys that sort the highest
+// score first; the bits of a positive float order the same way as
+// the float itself, so inverting them reverses the order.
+func relevanceKey(n queryNode) func(*LibraryEntry) string {
+	var stems []string
+	for s := range queryStems(n) {
+		stems = append(stems, s)
+	}
+	scores := index.score(stems)
+	return func(e *LibraryEntry) string {
+		return fmt.Sprintf("%016x", math.MaxUint64-math.Float64bits(scores[e.Filename]))
+	}
+}
+
+// values returns the query as URL parameters, with the cursor
+// replaced.  This is used to build the links between pages.
+func (q *listQuery) values(cursor string) url.Values {
+	v := url.Values{}
+	if q.Sort != "filename" {
+		v.Set("sort", q.Sort)
+	}
+	if q.Desc {
+		v.Set("order", "desc")
+	}
+	if q.Limit != defaultPageSize {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+	if cursor != "" {
+		v.Set("cursor", cursor)
+	}
+	if len(q.Fields) > 0 {
+		v.Set("fields", strings.Join(q.Fields, ","))
+	}
+	for _, t := range q.Tags {
+		v.Add("tag", t)
+	}
+	for _, m := range q.Missing {
+		v.Add("missing", m)
+	}
+	for _, k := range q.Kinds {
+		v.Add("kind", k)
+	}
+	if !q.From.IsZero() {
+		v.Set("from", q.From.Format("2006-01-02"))
+	}
+	if !q.To.IsZero() {
+		v.Set("to", q.To.Format("2006-01-02"))
+	}
+	if q.Q != "" {
+		v.Set("q", q.Q)
+	}
+	return v
+}
+
+func encodeCursor(key, filename string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key + "\x00" + filename))
+}
+
+func decodeCursor(c string) (string, string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(c)
+	if err != nil {
+		return "", "", fmt.Errorf("bad cursor")
+	}
+	parts := strings.SplitN(string(b), "\x00", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("bad cursor")
+	}
+	return parts[0], parts[1], nil
+}
+
+// selectFields reduces the entries to only the requested fields.  If
+// no fields were requested the entries are returned unchanged.
+func (q *listQuery) selectFields(entries []*LibraryEntry) interface{} {
+	if len(q.Fields) == 0 {
+		return entries
+	}
+	out := make([]map[string]interface{}, 0, len(entries))
+	for _, e := range entries {
+		m := make(map[string]interface{}, len(q.Fields))
+		for _, f := range q.Fields {
+			m[f] = listFields[f](e)
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+func videosHandler(w http.ResponseWriter, r *http.Request) {
+	q, err := parseListQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	libraryLock.RLock()
+	page, err := q.run(library)
+	libraryLock.RUnlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp := struct {
+		Entries interface{}
+		Total   int
+		Next    string `json:",omitempty"`
+	}{
+		Entries: q.selectFields(page.Entries),
+		Total:   page.Total,
+		Next:    page.Next,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Printf("videosHandler: encode error: %s", err)
+	}
+}
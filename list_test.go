@@ -0,0 +1,139 @@
+package main
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// listAll pages through the results of a query, returning the file
+// names in order.
+func listAll(t *testing.T, q *listQuery, lib map[string]*LibraryEntry) []string {
+	var names []string
+	for pages := 0; ; pages++ {
+		if pages > len(lib) {
+			t.Fatal("paging doesn't end")
+		}
+		page, err := q.run(lib)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.Total != len(lib) {
+			t.Errorf("total %d, want %d", page.Total, len(lib))
+		}
+		for _, e := range page.Entries {
+			names = append(names, e.Filename)
+		}
+		if page.Next == "" {
+			return names
+		}
+		q.Cursor = page.Next
+	}
+}
+
+func TestListQueryPaging(t *testing.T) {
+	day := func(s string) vTime {
+		d, _ := time.Parse("2006-01-02", s)
+		return vTime{d}
+	}
+	lib := make(map[string]*LibraryEntry)
+	for _, e := range []*LibraryEntry{
+		{Filename: "a.mp4", Date: day("2017-03-01")},
+		{Filename: "b.mp4", Date: day("2017-01-01")},
+		{Filename: "c.mp4", Date: day("2017-03-01")},
+		{Filename: "d.mp4", Date: day("2017-03-01")},
+		{Filename: "e.mp4", Date: day("2016-12-31")},
+		{Filename: "f.mp4"},
+	} {
+		lib[e.Filename] = e
+	}
+
+	tests := []struct {
+		sort  string
+		desc  bool
+		limit int
+		want  string
+	}{
+		{"filename", false, 4, "a b c d e f"},
+		{"filename", true, 4, "f e d c b a"},
+		{"date", false, 2, "f e b a c d"},
+		{"date", true, 2, "d c a b e f"},
+		{"date", false, 1, "f e b a c d"},
+		{"date", true, 10, "d c a b e f"},
+	}
+	for _, tt := range tests {
+		q := &listQuery{Sort: tt.sort, Desc: tt.desc, Limit: tt.limit, Search: allNode{}}
+		got := strings.Join(listAll(t, q, lib), " ")
+		want := strings.Replace(tt.want, " ", ".mp4 ", -1) + ".mp4"
+		if got != want {
+			t.Errorf("sort %s desc %v limit %d: %s, want %s", tt.sort, tt.desc, tt.limit, got, want)
+		}
+	}
+}
+
+func TestListQueryCursorSurvivesChanges(t *testing.T) {
+	lib := map[string]*LibraryEntry{
+		"a.mp4": {Filename: "a.mp4", Title: "Same"},
+		"b.mp4": {Filename: "b.mp4", Title: "Same"},
+		"c.mp4": {Filename: "c.mp4", Title: "Same"},
+		"d.mp4": {Filename: "d.mp4", Title: "Same"},
+	}
+	q := &listQuery{Sort: "title", Limit: 2, Search: allNode{}}
+	page, err := q.run(lib)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The last entry of the page going away doesn't move the
+	// next page.
+	delete(lib, "b.mp4")
+	q.Cursor = page.Next
+	page, err = q.run(lib)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range page.Entries {
+		got = append(got, e.Filename)
+	}
+	if want := []string{"c.mp4", "d.mp4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("next page %q, want %q", got, want)
+	}
+
+	q.Cursor = "!!"
+	if _, err := q.run(lib); err == nil {
+		t.Error("no error for a bad cursor")
+	}
+}
+
+func TestParseListQuery(t *testing.T) {
+	q, err := parseListQuery(httptest.NewRequest("GET", "/videos?q=garden&kind=Video&tag=a,b&tag=c&limit=100000", nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Sort != "relevance" || q.Limit != maxPageSize {
+		t.Errorf("sort %q limit %d, want relevance and the largest page", q.Sort, q.Limit)
+	}
+	if !reflect.DeepEqual(q.Tags, []string{"a", "b", "c"}) || !reflect.DeepEqual(q.Kinds, []string{"video"}) {
+		t.Errorf("tags %q kinds %q", q.Tags, q.Kinds)
+	}
+	if v := q.values("").Encode(); v != "kind=video&limit=1000&q=garden&sort=relevance&tag=a&tag=b&tag=c" {
+		t.Errorf("values %s", v)
+	}
+
+	for _, query := range []string{
+		"sort=size",
+		"limit=0",
+		"fields=Size",
+		"missing=poster",
+		"kind=document",
+		"q=(",
+		"from=yesterday",
+	} {
+		if _, err := parseListQuery(httptest.NewRequest("GET", "/videos?"+query, nil)); err == nil {
+			t.Errorf("no error for %s", query)
+		}
+	}
+}
@@ -7,30 +7,72 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
+	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/arschles/go-bindata-html-template"
 	"github.com/elazarl/go-bindata-assetfs"
 )
 
-// LibraryEntry defines a single video and all information known about
-// it.
+// LibraryEntry defines a single video, audio file or still image and
+// all information known about it.
 type LibraryEntry struct {
 	Filename    string
 	Title       string
 	Tags        []string
 	Date        vTime
 	Description string
+
+	// Modified is the last time the metadata was updated.
+	Modified time.Time
+
+	// Extra holds the values of the custom fields.
+	Extra map[string]string `json:",omitempty"`
+
+	// Annotations mark stretches of the video and Chapters divide
+	// it into sections.  Both are changed through their own
+	// endpoints rather than by updates.
+	Annotations []Annotation `json:",omitempty"`
+	Chapters    []Chapter    `json:",omitempty"`
+
+	// MIME is the type of the file and Subtitles are the sidecars
+	// found next to it, both worked out when scanning.
+	MIME      string     `json:",omitempty"`
+	Subtitles []Subtitle `json:",omitempty"`
+
+	// Kind is video, audio or image, guessed from the extension
+	// when scanning and confirmed by probing.
+	Kind string `json:",omitempty"`
+
+	// Poster is the time of the frame chosen as the thumbnail, or
+	// zero for the default frame.
+	Poster float64 `json:",omitempty"`
+
+	// Proxy is the small rendition played for review, kept up to
+	// date by proxy jobs.
+	Proxy *Rendition `json:",omitempty"`
+
+	// ClipOf tells which video an exported clip was cut from.
+	ClipOf *Provenance `json:",omitempty"`
 }
 
 type vTime struct {
 	time.Time
 }
 
+// UnmarshalJSON reads a date written as 2006-01-02.  An empty date,
+// as sent for a blank date input, or null is the zero time.
 func (v *vTime) UnmarshalJSON(buf []byte) error {
-	tt, err := time.Parse("2006-01-02", strings.Trim(string(buf), `"`))
+	s := strings.Trim(string(buf), `"`)
+	if s == "" || s == "null" {
+		v.Time = time.Time{}
+		return nil
+	}
+	tt, err := time.Parse("2006-01-02", s)
 	if err != nil {
 		return err
 	}
@@ -45,29 +87,141 @@ func (v *vTime) MarshalJSON() ([]byte, error) {
 var (
 	listTmpl *template.Template
 	plyrTmpl *template.Template
+	audiTmpl *template.Template
+	imgeTmpl *template.Template
 	statTmpl *template.Template
+	srchTmpl *template.Template
+	tagsTmpl *template.Template
+	ruleTmpl *template.Template
+	vocbTmpl *template.Template
+	nmspTmpl *template.Template
+	fildTmpl *template.Template
+	jobsTmpl *template.Template
 
 	port         = flag.Int("port", 8080, "Serving port")
 	videoDir     = flag.String("video_dir", "video", "Directory to search for files to be tagged")
 	saveInterval = flag.Duration("save_interval", 5*time.Minute, "How often to back up the database to disk")
+	cacheDir     = flag.String("cache_dir", "cache", "Directory for generated files such as thumbnails")
+	thumbCmd     = flag.String("thumb_cmd", "", "Command writing the frame of {input} at {time} seconds to the image {output}, such as: ffmpeg -ss {time} -i {input} -frames:v 1 -vf scale=320:-1 -c:v mjpeg -f image2 -y {output}")
+	thumbTime    = flag.Duration("thumb_time", 5*time.Second, "Time of the default thumbnail frame")
+
+	workers            = flag.Int("workers", 2, "How many background jobs run at once")
+	storyboardInterval = flag.Duration("storyboard_interval", 10*time.Second, "Time between the frames of the storyboards used for previews when seeking")
+
+	hlsCmd       = flag.String("hls_cmd", "", "Command packaging {input} for HLS into the directory {dir} with the playlist {playlist}, such as: ffmpeg -i {input} -c copy -f hls -hls_time 6 -hls_playlist_type vod -hls_segment_filename {dir}/%05d.ts {playlist}.  \"stub\" is for testing only and serves the whole file as a single segment, which isn't adaptive streaming")
+	proxyCmd     = flag.String("proxy_cmd", "", "Command encoding {input} as the small MP4 proxy {output} played for review, such as: ffmpeg -i {input} -vf scale=-2:480 -c:v libx264 -preset veryfast -crf 28 -c:a aac -b:a 96k -movflags +faststart -y {output}")
+	clipCmd      = flag.String("clip_cmd", "", "Command cutting the clip from {start} to {end} seconds, {duration} long, out of {input} into {output} without encoding, such as: ffmpeg -ss {start} -i {input} -t {duration} -map 0 -c copy -avoid_negative_ts make_zero -y {output}")
+	pcmCmd       = flag.String("pcm_cmd", "", "Command printing the audio of {input} as signed 16 bit little endian mono samples at {rate} Hz, for waveforms, such as: ffmpeg -i {input} -vn -ac 1 -ar {rate} -f s16le -")
+	silenceDB    = flag.Int("silence_db", -40, "Level in dBFS under which audio counts as silence")
+	silenceMin   = flag.Duration("silence_min", 2*time.Second, "Shortest silence separating the proposed segments")
+	hlsCacheSize = flag.Int64("hls_cache_mb", 20480, "Megabytes of packaged HLS streams to keep before evicting the least recently played")
 
 	healthy = "OK"
 	dbDirty = false
 	library map[string]*LibraryEntry
+
+	// libraryLock guards the library map and everything else that
+	// is kept in the database.  Entries are replaced rather than
+	// changed in place, so an entry fetched under the lock can
+	// still be read after it is released.
+	libraryLock sync.RWMutex
+
+	// libraryVersion is bumped on every change to the library so
+	// that derived data can tell when it is stale.
+	libraryVersion int
 )
 
+// tmplFuncs are the helpers available to the page templates.
+var tmplFuncs = template.FuncMap{
+	"savedSearches":    savedSearchList,
+	"namespaces":       namespaces.list,
+	"tagColor":         namespaces.color,
+	"customFields":     customFields.list,
+	"timecode":         formatTimecode,
+	"hlsEnabled":       hlsEnabled,
+	"clipsEnabled":     clipsEnabled,
+	"waveformsEnabled": waveformsEnabled,
+}
+
+// dbVersion is the current layout of the database file.  Version 0
+// is the original layout, which was just the library map.
+const dbVersion = 1
+
+// database is the layout of the database file.
+type database struct {
+	Version  int
+	Library  map[string]*LibraryEntry
+	Searches map[string]*SavedSearch
+	TagOps   []*TagOp
+	TagRules *TagRules
+	Vocab    *Vocabulary
+
+	Namespaces []*Namespace
+	Fields     []*Field
+
+	// Jobs are the background jobs still to be done and Hashes the
+	// content hashes worked out so far.
+	Jobs   []*Job
+	Hashes map[string]hashMemo
+}
+
 func init() {
 	var err error
-	listTmpl, err = template.New("list", Asset).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/list.tmpl")
+	listTmpl, err = template.New("list", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/list.tmpl")
 	if err != nil {
 		log.Fatalf("Could not load listTmpl: %s", err)
 	}
 
-	plyrTmpl, err = template.New("player", Asset).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/plyr.tmpl")
+	plyrTmpl, err = template.New("player", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/entry.tmpl", "static/tmpl/plyr.tmpl")
 	if err != nil {
 		log.Fatalf("Could not load plyrTmpl: %s", err)
 	}
 
+	audiTmpl, err = template.New("audio", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/entry.tmpl", "static/tmpl/audio.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load audiTmpl: %s", err)
+	}
+
+	imgeTmpl, err = template.New("image", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/entry.tmpl", "static/tmpl/image.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load imgeTmpl: %s", err)
+	}
+
+	srchTmpl, err = template.New("search", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/search.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load srchTmpl: %s", err)
+	}
+
+	tagsTmpl, err = template.New("tags", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/tags.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load tagsTmpl: %s", err)
+	}
+
+	ruleTmpl, err = template.New("rules", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/rules.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load ruleTmpl: %s", err)
+	}
+
+	vocbTmpl, err = template.New("vocab", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/vocab.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load vocbTmpl: %s", err)
+	}
+
+	nmspTmpl, err = template.New("namespaces", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/namespaces.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load nmspTmpl: %s", err)
+	}
+
+	fildTmpl, err = template.New("fields", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/fields.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load fildTmpl: %s", err)
+	}
+
+	jobsTmpl, err = template.New("jobs", Asset).Funcs(tmplFuncs).ParseFiles("static/tmpl/main.tmpl", "static/tmpl/jobs.tmpl")
+	if err != nil {
+		log.Fatalf("Could not load jobsTmpl: %s", err)
+	}
+
 	statTmpl, err = template.New("stat", Asset).ParseFiles("static/tmpl/status.tmpl")
 	if err != nil {
 		log.Fatalf("Could not load statTmpl: %s", err)
@@ -89,26 +243,98 @@ func statusHandler(w http.ResponseWriter, r *http.Request) {
 		Library:  library,
 	}
 
+	libraryLock.RLock()
 	err := statTmpl.ExecuteTemplate(w, "stat", s)
+	libraryLock.RUnlock()
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
 
 func listHandler(w http.ResponseWriter, r *http.Request) {
-	err := listTmpl.ExecuteTemplate(w, "layout", library)
+	q, err := parseListQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	libraryLock.RLock()
+	page, err := q.run(library)
+	libraryLock.RUnlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s := struct {
+		*listPage
+		Query    *listQuery
+		NextLink string
+	}{
+		listPage: page,
+		Query:    q,
+	}
+	if page.Next != "" {
+		s.NextLink = "/list?" + q.values(page.Next).Encode()
+	}
+
+	err = listTmpl.ExecuteTemplate(w, "layout", s)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
 }
 
+// playerPage is an entry along with the file the player plays.
+type playerPage struct {
+	*LibraryEntry
+	Source    string
+	Type      string
+	PlayProxy bool
+	HasProxy  bool
+
+	// Clips are the clips exported from the video.
+	Clips []*LibraryEntry
+}
+
 func playerHandler(w http.ResponseWriter, r *http.Request) {
 	err := r.ParseForm()
 	if err != nil {
 		fmt.Fprintf(w, "Error decoding request!")
 	}
 
-	err = plyrTmpl.ExecuteTemplate(w, "layout", library[r.FormValue("file")])
+	libraryLock.RLock()
+	entry := library[r.FormValue("file")]
+	var clips []*LibraryEntry
+	if entry != nil {
+		clips = clipsOf(entry.Filename)
+	}
+	libraryLock.RUnlock()
+	if entry == nil {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+
+	// The proxy is played unless the original is asked for.
+	p := playerPage{LibraryEntry: entry, Source: "/video-file/" + entry.Filename, Type: entry.MIME, Clips: clips}
+	if _, ok := readyProxy(entry); ok && entryKind(entry) == kindVideo {
+		p.HasProxy = true
+		if r.FormValue("original") == "" {
+			p.PlayProxy = true
+			p.Source = "/proxy?file=" + url.QueryEscape(entry.Filename)
+			p.Type = "video/mp4"
+		}
+	}
+
+	// Each kind of media has a page of its own, sharing the
+	// metadata form.
+	tmpl := plyrTmpl
+	switch entryKind(entry) {
+	case kindAudio:
+		tmpl = audiTmpl
+	case kindImage:
+		tmpl = imgeTmpl
+	}
+	err = tmpl.ExecuteTemplate(w, "layout", p)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 	}
@@ -120,7 +346,11 @@ func infoHandler(w http.ResponseWriter, r *http.Request) {
 		log.Println("infoHandler: form parse error!")
 	}
 
-	json.NewEncoder(w).Encode(library[r.FormValue("file")])
+	libraryLock.RLock()
+	entry := library[r.FormValue("file")]
+	libraryLock.RUnlock()
+
+	json.NewEncoder(w).Encode(entry)
 }
 
 func updateHandler(w http.ResponseWriter, r *http.Request) {
@@ -142,18 +372,59 @@ func updateHandler(w http.ResponseWriter, r *http.Request) {
 	entry := &LibraryEntry{}
 	err = json.NewDecoder(r.Body).Decode(&entry)
 	if err != nil {
-		log.Println("updateHandler: json decode fault!")
+		log.Printf("updateHandler: json decode fault: %s", err)
+		http.Error(w, "bad update: "+err.Error(), http.StatusBadRequest)
+		return
 	}
+	// The library is keyed by the file named in the request, which
+	// the index and vocabulary have to agree with.
+	entry.Filename = file
 	log.Printf("Updating metadata for %s", file)
+	entry.Modified = time.Now()
+	libraryLock.Lock()
+	if old := library[file]; old != nil {
+		entry.Annotations = old.Annotations
+		entry.Chapters = old.Chapters
+		entry.MIME = old.MIME
+		entry.Kind = old.Kind
+		entry.Subtitles = old.Subtitles
+		entry.Poster = old.Poster
+		entry.Proxy = old.Proxy
+		entry.ClipOf = old.ClipOf
+	}
+	entry.Tags = tagRules.apply(entry.Tags)
+	entry.Tags, err = namespaces.check(entry.Tags)
+	if err == nil {
+		entry.Extra, err = customFields.check(entry.Extra)
+	}
+	var proposed []string
+	if err == nil {
+		proposed, err = vocab.enforce(entry)
+	}
+	if err != nil {
+		libraryLock.Unlock()
+		log.Printf("Rejected update for %s: %s", file, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	library[file] = entry
+	index.update(entry)
 
 	// mark the DB dirty, this causes the backup to actually do things
 	dbDirty = true
+	libraryVersion++
+	libraryLock.Unlock()
+
+	if len(proposed) > 0 {
+		fmt.Fprintf(w, "These tags are waiting for approval: %s", strings.Join(proposed, ", "))
+	}
 }
 
 func dbDumpHandler(w http.ResponseWriter, r *http.Request) {
 	// This function is almost entirely for dumping the database
 	// during test or similar purposes.
+	libraryLock.RLock()
+	defer libraryLock.RUnlock()
 	json.NewEncoder(w).Encode(library)
 }
 
@@ -171,22 +442,116 @@ func findVideos() {
 		log.Printf("Error globbing videos: %s", err)
 	}
 
+	names := files[:0]
+	for _, v := range files {
+		// Hidden files include clips still being exported.
+		if v = filepath.Base(v); !strings.HasPrefix(v, ".") {
+			names = append(names, v)
+		}
+	}
+	files = names
+	subs := findSubtitles(files)
+	for _, s := range subs {
+		loadSubtitles(s)
+	}
+
 	log.Println("Located the following files:")
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
 	for _, v := range files {
-		v = filepath.Base(v)
+		if isSubtitle(v) {
+			// Subtitles belong to their video.  Older scans
+			// added them as videos, those entries are dropped
+			// unless someone filled them in.
+			if e := library[v]; e != nil && e.Title == "" && e.Description == "" && len(cleanTags(e.Tags)) == 0 {
+				log.Printf("  Dropping subtitle entry: %s", v)
+				delete(library, v)
+				index.drop(v)
+				dbDirty = true
+				libraryVersion++
+			}
+			continue
+		}
 		if library[v] == nil {
 			// Add a file we haven't seen before
 			log.Printf("  New File: %s", v)
-			library[v] = &LibraryEntry{Filename: v}
+			library[v] = &LibraryEntry{Filename: v, Subtitles: subs[v], Kind: mediaKind(guessMIME(v))}
+			index.update(library[v])
+			libraryVersion++
 		} else {
 			log.Printf("  Known File: %s", v)
+			old := library[v]
+			if !sameSubtitles(old.Subtitles, subs[v]) || old.Kind == "" {
+				e := *old
+				e.Subtitles = subs[v]
+				if e.Kind == "" {
+					e.Kind = mediaKind(guessMIME(v))
+				}
+				library[v] = &e
+				dbDirty = true
+				libraryVersion++
+			}
+			if len(subs[v]) > 0 || len(old.Subtitles) > 0 {
+				// The subtitle text has only just been read.
+				index.update(library[v])
+			}
 		}
+
+		queueMediaJobs(library[v])
+	}
+}
+
+// queueMediaJobs queues the work still missing for a file of the
+// library: probing until its type is known, and the thumbnail,
+// storyboard, proxy and waveform until they are cached for its
+// current contents.  Files that were never hashed get everything,
+// the jobs find out what is done.  Storyboards and proxies are only
+// made for videos, and waveforms for anything with sound.
+func queueMediaJobs(e *LibraryEntry) {
+	file, kind := e.Filename, entryKind(e)
+	if e.MIME == "" || e.Kind == "" {
+		jobs.add("probe", file, nil)
+	}
+	hash, hashed := knownHash(file)
+	missing := func(path string) bool {
+		if !hashed {
+			return true
+		}
+		_, err := os.Stat(path)
+		return err != nil
+	}
+	if missing(thumbPath(hash, posterTime(e.Poster))) {
+		jobs.add("thumbnail", file, nil)
+	}
+	if *thumbCmd != "" && kind == kindVideo && missing(storyboardPath(hash)+".json") {
+		jobs.add("storyboard", file, nil)
+	}
+	if _, ready := readyProxy(e); *proxyCmd != "" && kind == kindVideo && !ready {
+		jobs.add("proxy", file, nil)
+	}
+	if waveformsEnabled() && kind != kindImage && missing(waveformPath(hash)) {
+		jobs.add("waveform", file, nil)
 	}
 }
 
 func dbBackup() {
 	log.Println("Backup up database")
-	d, err := json.Marshal(library)
+	libraryLock.RLock()
+	d, err := json.Marshal(database{
+		Version:  dbVersion,
+		Library:  library,
+		Searches: searches,
+		TagOps:   tagOps,
+		TagRules: tagRules,
+		Vocab:    vocab,
+
+		Namespaces: namespaces.list(),
+		Fields:     customFields.list(),
+
+		Jobs:   jobs.snapshot(),
+		Hashes: hashSnapshot(),
+	})
+	libraryLock.RUnlock()
 	if err != nil {
 		log.Println("Marshaling error during database backup!")
 		// flip the global status to bad here
@@ -202,7 +567,7 @@ func dbBackup() {
 
 func dbBackupTimer() {
 	for range time.Tick(*saveInterval) {
-		if dbDirty {
+		if dbDirty || jobs.changed() {
 			dbBackup()
 			dbDirty = false
 		}
@@ -215,10 +580,47 @@ func dbLoad() {
 	if err != nil {
 		log.Fatalf("Could not load database: %s", err)
 	}
-	err = json.Unmarshal(d, &library)
+	var db database
+	err = json.Unmarshal(d, &db)
 	if err != nil {
 		log.Fatalf("Could not unpack database: %s", err)
 	}
+	if db.Version == 0 {
+		// The original database was only the library, it will
+		// be rewritten in the current layout on the next backup.
+		log.Println("Upgrading database from version 0")
+		err = json.Unmarshal(d, &db.Library)
+		if err != nil {
+			log.Fatalf("Could not unpack database: %s", err)
+		}
+	}
+	if db.Library != nil {
+		library = db.Library
+	}
+	if db.Searches != nil {
+		searches = db.Searches
+	}
+	tagOps = db.TagOps
+	if db.TagRules != nil {
+		tagRules = db.TagRules
+	}
+	if db.Vocab != nil {
+		vocab = db.Vocab
+	}
+	namespaces.set(db.Namespaces)
+	customFields.set(db.Fields)
+	if db.Hashes != nil {
+		hashes.Lock()
+		hashes.m = db.Hashes
+		hashes.Unlock()
+	}
+	jobs.load(db.Jobs)
+	for _, op := range tagOps {
+		if op.ID >= nextTagOp {
+			nextTagOp = op.ID + 1
+		}
+	}
+	index.rebuild(library)
 	log.Println("Database load complete")
 }
 
@@ -238,6 +640,42 @@ func main() {
 	http.HandleFunc("/info", infoHandler)
 	http.HandleFunc("/update", updateHandler)
 	http.HandleFunc("/db", dbDumpHandler)
+	http.HandleFunc("/videos", videosHandler)
+	http.HandleFunc("/search", searchHandler)
+	http.HandleFunc("/tags", tagsHandler)
+	http.HandleFunc("/tags/rename", tagOpHandler)
+	http.HandleFunc("/tags/merge", tagOpHandler)
+	http.HandleFunc("/tags/move", tagOpHandler)
+	http.HandleFunc("/tags/delete", tagOpHandler)
+	http.HandleFunc("/tags/undo", tagUndoHandler)
+	http.HandleFunc("/tags/history", tagHistoryHandler)
+	http.HandleFunc("/tags/complete", tagCompleteHandler)
+	http.HandleFunc("/rules", rulesHandler)
+	http.HandleFunc("/rules/apply", rulesApplyHandler)
+	http.HandleFunc("/annotations", annotationsHandler)
+	http.HandleFunc("/annotations/delete", annotationsHandler)
+	http.HandleFunc("/subtitles", subtitlesHandler)
+	http.HandleFunc("/thumbnail", thumbnailHandler)
+	http.HandleFunc("/storyboard", storyboardHandler)
+	http.HandleFunc("/proxy", proxyHandler)
+	http.HandleFunc("/clips", clipsHandler)
+	http.HandleFunc("/waveform", waveformHandler)
+	http.HandleFunc("/waveform/annotate", waveformAnnotateHandler)
+	http.HandleFunc("/hls", hlsHandler)
+	http.HandleFunc("/hls/", hlsFileHandler)
+	http.HandleFunc("/jobs", jobsHandler)
+	http.HandleFunc("/jobs/cancel", jobCancelHandler)
+	http.HandleFunc("/jobs/retry", jobRetryHandler)
+	http.HandleFunc("/chapters", chaptersHandler)
+	http.HandleFunc("/chapters/import", chapterImportHandler)
+	http.HandleFunc("/namespaces", namespacesHandler)
+	http.HandleFunc("/fields", fieldsHandler)
+	http.HandleFunc("/vocab", vocabHandler)
+	http.HandleFunc("/vocab/approve", vocabDecideHandler)
+	http.HandleFunc("/vocab/reject", vocabDecideHandler)
+	http.HandleFunc("/searches", savedSearchesHandler)
+	http.HandleFunc("/searches/save", saveSearchHandler)
+	http.HandleFunc("/searches/delete", deleteSearchHandler)
 	http.Handle("/video-file/", http.StripPrefix("/video-file/", http.FileServer(http.Dir(*videoDir))))
 
 	http.Handle("/static/",
@@ -251,9 +689,11 @@ func main() {
 	)
 
 	library = make(map[string]*LibraryEntry)
+	searches = make(map[string]*SavedSearch)
 
 	// Init some state
 	dbLoad()
+	go jobs.run()
 	findVideos()
 	dbBackup()
 
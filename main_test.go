@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVTimeUnmarshal(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+		err  bool
+	}{
+		{`"2017-03-01"`, time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC), false},
+		{`""`, time.Time{}, false},
+		{`null`, time.Time{}, false},
+		{`"March 1st"`, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		var v vTime
+		err := json.Unmarshal([]byte(tt.in), &v)
+		if (err != nil) != tt.err || !v.Time.Equal(tt.want) {
+			t.Errorf("Unmarshal(%s) = %v, %v; want %v, error %v", tt.in, v.Time, err, tt.want, tt.err)
+		}
+	}
+}
+
+// withLibrary swaps in a library of the given entries, returning a
+// function restoring the real one.
+func withLibrary(entries ...*LibraryEntry) func() {
+	old, oldIndex := library, index
+	library = make(map[string]*LibraryEntry)
+	index = newTextIndex()
+	for _, e := range entries {
+		library[e.Filename] = e
+		index.update(e)
+	}
+	return func() { library, index = old, oldIndex }
+}
+
+func TestUpdateHandlerUsesRequestFile(t *testing.T) {
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4", Title: "Alpha"})()
+
+	body := `{"Filename": "b.mp4", "Title": "Zebra crossing", "Date": "", "Tags": ["x"]}`
+	w := httptest.NewRecorder()
+	updateHandler(w, httptest.NewRequest("POST", "/update?file=a.mp4", strings.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+	e := library["a.mp4"]
+	if e == nil || e.Filename != "a.mp4" || e.Title != "Zebra crossing" {
+		t.Fatalf("entry = %+v", e)
+	}
+	if library["b.mp4"] != nil {
+		t.Error("the update added an entry for the filename in the body")
+	}
+	if !index.contains("a.mp4", []string{stem("zebra")}) || index.contains("b.mp4", []string{stem("zebra")}) {
+		t.Error("the index wasn't updated under the requested file")
+	}
+}
+
+func TestUpdateHandlerRejectsBadJSON(t *testing.T) {
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4", Title: "Alpha", Description: "Kept"})()
+
+	body := `{"Title": "Beta", "Date": "yesterday", "Description": "Lost"}`
+	w := httptest.NewRecorder()
+	updateHandler(w, httptest.NewRequest("POST", "/update?file=a.mp4", strings.NewReader(body)))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	if e := library["a.mp4"]; e.Title != "Alpha" || e.Description != "Kept" {
+		t.Errorf("entry was overwritten: %+v", e)
+	}
+}
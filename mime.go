@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultMIME is used for files that can't be identified.
+const defaultMIME = "application/octet-stream"
+
+// Media kinds.  Each kind has a player page of its own, and the jobs
+// run for an entry depend on its kind.
+const (
+	kindVideo = "video"
+	kindAudio = "audio"
+	kindImage = "image"
+)
+
+// mediaKinds are the kinds in the order they are offered as filters.
+var mediaKinds = []string{kindVideo, kindAudio, kindImage}
+
+// extensionMIME maps the extensions of common media files to their
+// MIME types, for systems without a MIME database and for files
+// whose contents aren't recognized.
+var extensionMIME = map[string]string{
+	".3gp":  "video/3gpp",
+	".avi":  "video/x-msvideo",
+	".flv":  "video/x-flv",
+	".m2ts": "video/mp2t",
+	".m4v":  "video/mp4",
+	".mkv":  "video/x-matroska",
+	".mov":  "video/quicktime",
+	".mp4":  "video/mp4",
+	".mpeg": "video/mpeg",
+	".mpg":  "video/mpeg",
+	".ogv":  "video/ogg",
+	".ts":   "video/mp2t",
+	".webm": "video/webm",
+	".wmv":  "video/x-ms-wmv",
+
+	".aac":  "audio/aac",
+	".flac": "audio/flac",
+	".m4a":  "audio/mp4",
+	".mp3":  "audio/mpeg",
+	".oga":  "audio/ogg",
+	".ogg":  "audio/ogg",
+	".opus": "audio/ogg",
+	".wav":  "audio/wav",
+
+	".gif":  "image/gif",
+	".jpeg": "image/jpeg",
+	".jpg":  "image/jpeg",
+	".png":  "image/png",
+	".tif":  "image/tiff",
+	".tiff": "image/tiff",
+	".webp": "image/webp",
+}
+
+// mediaKind returns the kind of media with a MIME type.  Anything
+// that isn't known to be audio or an image is taken to be a video,
+// as the library used to hold nothing else.
+func mediaKind(t string) string {
+	switch {
+	case strings.HasPrefix(t, "audio/"):
+		return kindAudio
+	case strings.HasPrefix(t, "image/"):
+		return kindImage
+	}
+	return kindVideo
+}
+
+// entryKind returns the kind of an entry, which is a video for
+// entries that haven't been probed since kinds were added.
+func entryKind(e *LibraryEntry) string {
+	if e.Kind == "" {
+		return kindVideo
+	}
+	return e.Kind
+}
+
+// timedMedia reports if an entry plays over time, so that it can
+// have annotations, chapters and clips.
+func timedMedia(e *LibraryEntry) bool {
+	return entryKind(e) != kindImage
+}
+
+// sniffMIME identifies a container from the start of a file.  It
+// returns the empty string if the contents aren't recognized.
+func sniffMIME(head []byte) string {
+	switch {
+	case len(head) >= 12 && string(head[4:8]) == "ftyp":
+		switch brand := string(head[8:12]); {
+		case brand == "qt  ":
+			return "video/quicktime"
+		case brand == "M4A " || brand == "M4B ":
+			return "audio/mp4"
+		case strings.HasPrefix(brand, "3g"):
+			return "video/3gpp"
+		}
+		return "video/mp4"
+	case len(head) >= 8 && (string(head[4:8]) == "moov" || string(head[4:8]) == "mdat" || string(head[4:8]) == "wide"):
+		return "video/quicktime"
+	case bytes.HasPrefix(head, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		if ebmlDocType(head) == "webm" {
+			return "video/webm"
+		}
+		return "video/x-matroska"
+	case len(head) >= 12 && string(head[:4]) == "RIFF" && string(head[8:12]) == "AVI ":
+		return "video/x-msvideo"
+	case len(head) >= 12 && string(head[:4]) == "RIFF" && string(head[8:12]) == "WAVE":
+		return "audio/wav"
+	case len(head) >= 12 && string(head[:4]) == "RIFF" && string(head[8:12]) == "WEBP":
+		return "image/webp"
+	case bytes.HasPrefix(head, []byte("OggS")):
+		// The first page holds the header of the first stream,
+		// which is the video if there is one.
+		if bytes.Contains(head, []byte("\x80theora")) {
+			return "video/ogg"
+		}
+		if bytes.Contains(head, []byte("\x01vorbis")) || bytes.Contains(head, []byte("OpusHead")) || bytes.Contains(head, []byte("\x7fFLAC")) {
+			return "audio/ogg"
+		}
+		return "video/ogg"
+	case bytes.HasPrefix(head, []byte("FLV")):
+		return "video/x-flv"
+	case bytes.HasPrefix(head, []byte{0x30, 0x26, 0xB2, 0x75, 0x8E, 0x66, 0xCF, 0x11}):
+		return "video/x-ms-wmv"
+	case bytes.HasPrefix(head, []byte{0x00, 0x00, 0x01, 0xBA}):
+		return "video/mpeg"
+	case len(head) > 188 && head[0] == 0x47 && head[188] == 0x47:
+		return "video/mp2t"
+	case bytes.HasPrefix(head, []byte("ID3")):
+		return "audio/mpeg"
+	case bytes.HasPrefix(head, []byte("fLaC")):
+		return "audio/flac"
+	case len(head) >= 2 && head[0] == 0xFF && head[1]&0xF6 == 0xF0:
+		return "audio/aac"
+	case len(head) >= 2 && head[0] == 0xFF && head[1]&0xE0 == 0xE0:
+		return "audio/mpeg"
+	case bytes.HasPrefix(head, []byte{0xFF, 0xD8, 0xFF}):
+		return "image/jpeg"
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png"
+	case bytes.HasPrefix(head, []byte("GIF87a")) || bytes.HasPrefix(head, []byte("GIF89a")):
+		return "image/gif"
+	case bytes.HasPrefix(head, []byte("II*\x00")) || bytes.HasPrefix(head, []byte("MM\x00*")):
+		return "image/tiff"
+	}
+	return ""
+}
+
+// ebmlDocType returns the document type named in the EBML header at
+// the start of a Matroska or WebM file.
+func ebmlDocType(head []byte) string {
+	el, err := readEBMLHeader(bytes.NewReader(head), 0)
+	if err != nil || el.Size == ebmlUnknownSize || el.Start+el.Size > int64(len(head)) {
+		return ""
+	}
+	payload := head[el.Start : el.Start+el.Size]
+	children, err := ebmlChildren(payload)
+	if err != nil {
+		return ""
+	}
+	for _, c := range children {
+		if c.ID == ebmlDocTypeID {
+			return string(payload[c.Start : c.Start+c.Size])
+		}
+	}
+	return ""
+}
+
+// detectMIME works out the MIME type of a media file from its
+// contents, falling back to its extension.
+func detectMIME(path string) string {
+	f, err := os.Open(path)
+	if err == nil {
+		head := make([]byte, 512)
+		n, _ := io.ReadFull(f, head)
+		f.Close()
+		if t := sniffMIME(head[:n]); t != "" {
+			return t
+		}
+	}
+	return guessMIME(path)
+}
+
+// guessMIME works out the MIME type of a file from its extension
+// alone, which is enough to tell the kind of media before probing.
+func guessMIME(path string) string {
+	ext := strings.ToLower(filepath.Ext(path))
+	if t, ok := extensionMIME[ext]; ok {
+		return t
+	}
+	if t := mime.TypeByExtension(ext); t != "" {
+		return t
+	}
+	return defaultMIME
+}
+
+// probeJob works out the MIME type and the kind of a media file.
+func probeJob(ctx context.Context, j *Job) error {
+	t := detectMIME(filepath.Join(*videoDir, j.File))
+	kind := mediaKind(t)
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
+	old := library[j.File]
+	if old == nil || (old.MIME == t && old.Kind == kind) {
+		return nil
+	}
+	e := *old
+	e.MIME = t
+	e.Kind = kind
+	library[j.File] = &e
+	dbDirty = true
+	libraryVersion++
+	j.logf("Detected %s", t)
+	return nil
+}
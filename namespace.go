@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// namespaceSeparator divides the namespace of a tag such as
+// person:alice from its value.
+const namespaceSeparator = ":"
+
+// Namespace is a typed group of tags, so that people, places and
+// projects can be told apart from the other tags.  Tags in a
+// namespace are stored like any other tag, as namespace:value.
+type Namespace struct {
+	Name string
+
+	// Color is a CSS color used to draw the tags of the namespace.
+	Color string `json:",omitempty"`
+
+	// Single namespaces allow at most one value per entry.
+	Single bool
+
+	// Values lists the allowed values.  When empty any value is
+	// allowed.
+	Values []string `json:",omitempty"`
+}
+
+// allows returns the value as the namespace writes it, and false if
+// the value isn't allowed.
+func (ns *Namespace) allows(value string) (string, bool) {
+	if len(ns.Values) == 0 {
+		return value, true
+	}
+	for _, v := range ns.Values {
+		if strings.EqualFold(v, value) {
+			return v, true
+		}
+		if !tagMatches(value, v) {
+			continue
+		}
+		// The value is below v in the hierarchy.
+		if _, below, ok := splitTagLevels(value, strings.Count(v, tagSeparator)+1); ok {
+			return v + tagSeparator + below, true
+		}
+	}
+	return "", false
+}
+
+// namespaceSet holds the configured namespaces.  It has its own lock
+// since queries are parsed both with and without libraryLock held.
+type namespaceSet struct {
+	sync.RWMutex
+	byName map[string]*Namespace
+}
+
+var namespaces = &namespaceSet{byName: make(map[string]*Namespace)}
+
+// get returns the namespace with the given name, or nil.
+func (s *namespaceSet) get(name string) *Namespace {
+	s.RLock()
+	defer s.RUnlock()
+	return s.byName[strings.ToLower(name)]
+}
+
+// list returns the namespaces sorted by name.
+func (s *namespaceSet) list() []*Namespace {
+	s.RLock()
+	defer s.RUnlock()
+	out := make([]*Namespace, 0, len(s.byName))
+	for _, ns := range s.byName {
+		out = append(out, ns)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// set replaces the namespaces.
+func (s *namespaceSet) set(list []*Namespace) {
+	byName := make(map[string]*Namespace)
+	for _, ns := range list {
+		byName[ns.Name] = ns
+	}
+	s.Lock()
+	s.byName = byName
+	s.Unlock()
+}
+
+// split returns the namespace of a tag and its value.  Tags that
+// don't start with a configured namespace return nil.
+func (s *namespaceSet) split(tag string) (*Namespace, string) {
+	i := strings.Index(tag, namespaceSeparator)
+	if i <= 0 {
+		return nil, tag
+	}
+	ns := s.get(strings.TrimSpace(tag[:i]))
+	if ns == nil {
+		return nil, tag
+	}
+	return ns, strings.TrimSpace(tag[i+1:])
+}
+
+// namespaceTagsError is returned by updates whose namespaced tags
+// break the namespace configuration.
+type namespaceTagsError []string
+
+func (e namespaceTagsError) Error() string {
+	return strings.Join(e, "; ")
+}
+
+// check validates the namespaced tags of an entry and returns the
+// tags with namespaces and allowed values written the configured
+// way.
+func (s *namespaceSet) check(tags []string) ([]string, error) {
+	var errs namespaceTagsError
+	out := make([]string, 0, len(tags))
+	used := make(map[string][]string)
+	for _, t := range tags {
+		ns, value := s.split(t)
+		if ns == nil {
+			out = append(out, t)
+			continue
+		}
+		if value == "" {
+			errs = append(errs, fmt.Sprintf("%s needs a value", ns.Name))
+			continue
+		}
+		v, ok := ns.allows(value)
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%q is not an allowed %s", value, ns.Name))
+			continue
+		}
+		used[ns.Name] = append(used[ns.Name], v)
+		out = append(out, ns.Name+namespaceSeparator+v)
+	}
+	for _, ns := range s.list() {
+		if ns.Single && len(used[ns.Name]) > 1 {
+			errs = append(errs, fmt.Sprintf("%s takes a single value, got %s", ns.Name, strings.Join(used[ns.Name], ", ")))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return out, nil
+}
+
+// controls reports if a tag belongs to a namespace with a list of
+// allowed values, which then takes the place of the vocabulary.
+func (s *namespaceSet) controls(tag string) bool {
+	ns, _ := s.split(tag)
+	return ns != nil && len(ns.Values) > 0
+}
+
+// color returns the color of the namespace of a tag, if it has one.
+func (s *namespaceSet) color(tag string) string {
+	if ns, _ := s.split(tag); ns != nil {
+		return ns.Color
+	}
+	return ""
+}
+
+// namespaceName is what a namespace may be called, which is limited
+// to what the query language accepts as a field name.
+var namespaceName = regexp.MustCompile(`^[a-z][a-z_]*$`)
+
+// parseNamespaces reads namespaces written one per line as
+//
+//     name [color] [single|multi] [= value, other value]
+//
+// such as "place #2a7ab0 single = home, office".  Blank lines and
+// lines starting with # are ignored.
+func parseNamespaces(text string) ([]*Namespace, error) {
+	var out []*Namespace
+	seen := make(map[string]bool)
+	sc := bufio.NewScanner(strings.NewReader(text))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		ns := &Namespace{}
+		if i := strings.Index(line, "="); i >= 0 {
+			ns.Values = splitValues([]string{line[i+1:]})
+			line = line[:i]
+		}
+		words := strings.Fields(line)
+		if len(words) == 0 {
+			return nil, fmt.Errorf("line %d: no namespace name", n)
+		}
+		ns.Name = strings.ToLower(strings.TrimSuffix(words[0], namespaceSeparator))
+		if !namespaceName.MatchString(ns.Name) {
+			return nil, fmt.Errorf("line %d: %q is not a valid namespace name", n, words[0])
+		}
+		if isQueryField(ns.Name) {
+			return nil, fmt.Errorf("line %d: %q is already a search field", n, ns.Name)
+		}
+		if customFields.get(ns.Name) != nil {
+			return nil, fmt.Errorf("line %d: %q is already a custom field", n, ns.Name)
+		}
+		if seen[ns.Name] {
+			return nil, fmt.Errorf("line %d: namespace %q is defined twice", n, ns.Name)
+		}
+		seen[ns.Name] = true
+
+		for _, w := range words[1:] {
+			switch strings.ToLower(w) {
+			case "single":
+				ns.Single = true
+			case "multi":
+				ns.Single = false
+			default:
+				if ns.Color != "" {
+					return nil, fmt.Errorf("line %d: unexpected %q", n, w)
+				}
+				ns.Color = w
+			}
+		}
+		out = append(out, ns)
+	}
+	return out, nil
+}
+
+// formatNamespaces writes namespaces in the format parseNamespaces
+// reads.
+func formatNamespaces(list []*Namespace) string {
+	var lines []string
+	for _, ns := range list {
+		line := ns.Name
+		if ns.Color != "" {
+			line += " " + ns.Color
+		}
+		if ns.Single {
+			line += " single"
+		} else {
+			line += " multi"
+		}
+		if len(ns.Values) > 0 {
+			line += " = " + strings.Join(ns.Values, ", ")
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func namespacesHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s := struct {
+		Config string
+		Error  string
+	}{}
+
+	if r.Method == http.MethodPost {
+		s.Config = r.FormValue("namespaces")
+		list, err := parseNamespaces(s.Config)
+		if err != nil {
+			if wantJSON(r) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			s.Error = err.Error()
+		} else {
+			log.Printf("Updating tag namespaces: %d namespaces", len(list))
+			libraryLock.Lock()
+			namespaces.set(list)
+			dbDirty = true
+			libraryVersion++
+			libraryLock.Unlock()
+		}
+	}
+
+	list := namespaces.list()
+	if s.Error == "" {
+		s.Config = formatNamespaces(list)
+	}
+
+	if wantJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(list); err != nil {
+			log.Printf("namespacesHandler: encode error: %s", err)
+		}
+		return
+	}
+
+	err := nmspTmpl.ExecuteTemplate(w, "layout", s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNamespaceAllows(t *testing.T) {
+	ns := &Namespace{Name: "place", Values: []string{"Lab", "office/North", "Kitchen"}}
+	tests := []struct {
+		value string
+		want  string
+		ok    bool
+	}{
+		{"lab", "Lab", true},
+		{"LAB", "Lab", true},
+		{"lab/bay-2", "Lab/bay-2", true},
+		{"office/north", "office/North", true},
+		{"Office/North/Room 5", "office/North/Room 5", true},
+		{"office", "", false},
+		{"labs", "", false},
+		// The Kelvin sign folds to a one byte k.
+		{"\u212Aitchen", "Kitchen", true},
+		{"\u212Aitchen/Stove", "Kitchen/Stove", true},
+		{"\u212Aitchen/\u212Aettle", "Kitchen/\u212Aettle", true},
+		{"\u212A", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := ns.allows(tt.value)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("allows(%q) = %q, %v; want %q, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestNamespaceAllowsAnything(t *testing.T) {
+	ns := &Namespace{Name: "person"}
+	if got, ok := ns.allows("Ålice"); got != "Ålice" || !ok {
+		t.Errorf("allows(%q) = %q, %v", "Ålice", got, ok)
+	}
+}
+
+// withNamespaces replaces the namespaces, returning a function that
+// restores them.
+func withNamespaces(t *testing.T, config string) func() {
+	list, err := parseNamespaces(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := namespaces.list()
+	namespaces.set(list)
+	return func() { namespaces.set(old) }
+}
+
+func TestParseNamespaces(t *testing.T) {
+	list, err := parseNamespaces("# who and where\n\nPerson: #c33\nplace #2a7ab0 single = home, office/North\nproject multi\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Namespace{
+		{Name: "person", Color: "#c33"},
+		{Name: "place", Color: "#2a7ab0", Single: true, Values: []string{"home", "office/North"}},
+		{Name: "project"},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("got %+v, want %+v", list, want)
+	}
+	text := "person #c33 multi\nplace #2a7ab0 single = home, office/North\nproject multi"
+	if got := formatNamespaces(list); got != text {
+		t.Errorf("formatNamespaces = %q, want %q", got, text)
+	}
+
+	for _, in := range []string{
+		"= home",
+		"Person1",
+		"tag",
+		"title",
+		"person\nperson",
+		"person red blue",
+	} {
+		if _, err := parseNamespaces(in); err == nil {
+			t.Errorf("parseNamespaces(%q) gave no error", in)
+		}
+	}
+}
+
+func TestNamespaceCheck(t *testing.T) {
+	defer withNamespaces(t, "person\nplace single = Home, Office")()
+
+	tests := []struct {
+		tags []string
+		want []string
+		ok   bool
+	}{
+		{[]string{"Person: alice", "place:home", "holiday"}, []string{"person:alice", "place:Home", "holiday"}, true},
+		{[]string{"place:Home", "place:office"}, nil, false},
+		{[]string{"place:beach"}, nil, false},
+		{[]string{"person:"}, nil, false},
+		{[]string{"other:thing"}, []string{"other:thing"}, true},
+	}
+	for _, tt := range tests {
+		got, err := namespaces.check(tt.tags)
+		if (err == nil) != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("check(%q) = %q, %v", tt.tags, got, err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Rendition states.
+const (
+	renditionEncoding = "encoding"
+	renditionReady    = "ready"
+	renditionFailed   = "failed"
+)
+
+// Rendition is a copy of a video encoded for a purpose, such as the
+// small proxy played for review instead of the original.  Source is
+// the content hash of the video it was made from, so that it isn't
+// used once the video is replaced.
+type Rendition struct {
+	Status string
+	Source string
+	Size   int64  `json:",omitempty"`
+	Error  string `json:",omitempty"`
+}
+
+// proxyPath returns where the proxy of a video with a content hash
+// is cached.  Proxies are always MP4 files.
+func proxyPath(hash string) string {
+	return filepath.Join(*cacheDir, "proxies", hash+".mp4")
+}
+
+// readyProxy returns the path of the proxy of an entry if it has one
+// made from the current contents of the video.
+func readyProxy(e *LibraryEntry) (string, bool) {
+	if e.Proxy == nil || e.Proxy.Status != renditionReady {
+		return "", false
+	}
+	hash, ok := knownHash(e.Filename)
+	if !ok || hash != e.Proxy.Source {
+		return "", false
+	}
+	return proxyPath(hash), true
+}
+
+// setProxy records the state of the proxy of an entry.
+func setProxy(file string, p *Rendition) {
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
+	old := library[file]
+	if old == nil {
+		return
+	}
+	e := *old
+	e.Proxy = p
+	library[file] = &e
+	dbDirty = true
+	libraryVersion++
+}
+
+// proxyJob encodes the proxy of a video with the proxy command, which
+// gets the video as {input} and the MP4 file to write as {output}.
+func proxyJob(ctx context.Context, j *Job) error {
+	hash, err := videoHash(j.File)
+	if err != nil {
+		return err
+	}
+	out := proxyPath(hash)
+	if st, err := os.Stat(out); err == nil {
+		setProxy(j.File, &Rendition{Status: renditionReady, Source: hash, Size: st.Size()})
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0755); err != nil {
+		return err
+	}
+
+	setProxy(j.File, &Rendition{Status: renditionEncoding, Source: hash})
+	tmp := filepath.Join(filepath.Dir(out), hash+".part.mp4")
+	defer os.Remove(tmp)
+	err = runCommand(ctx, "proxy", *proxyCmd, map[string]string{
+		"input":  filepath.Join(*videoDir, j.File),
+		"output": tmp,
+	})
+	if err == nil {
+		if st, statErr := os.Stat(tmp); statErr != nil || st.Size() == 0 {
+			err = permanent(errors.New("proxy command wrote no video"))
+		}
+	}
+	if err == nil {
+		err = os.Rename(tmp, out)
+	}
+	if err != nil {
+		setProxy(j.File, &Rendition{Status: renditionFailed, Source: hash, Error: err.Error()})
+		return err
+	}
+
+	st, err := os.Stat(out)
+	if err != nil {
+		return err
+	}
+	setProxy(j.File, &Rendition{Status: renditionReady, Source: hash, Size: st.Size()})
+	j.logf("Encoded a %d MB proxy", st.Size()>>20)
+	return nil
+}
+
+// proxyHandler serves the proxy of a video.
+func proxyHandler(w http.ResponseWriter, r *http.Request) {
+	libraryLock.RLock()
+	e := library[r.FormValue("file")]
+	libraryLock.RUnlock()
+	if e == nil {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+	path, ok := readyProxy(e)
+	if !ok {
+		http.Error(w, "no proxy", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "video/mp4")
+	http.ServeFile(w, r, path)
+}
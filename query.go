@@ -0,0 +1,738 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// The search query language is a small boolean language over the
+// fields of a LibraryEntry.  Some examples:
+//
+//     tag:interview and not tag:raw
+//     date:2017 missing:description
+//     (tag:meeting or tag:standup) title:"weekly sync"
+//     date>=2017-03-01 date<2017-06 alice
+//     missing:tags modified>=-7d
+//     person:alice place:lab/* project:*
+//     rating>=4 license=cc0 shot:2017-05
+//     kind=audio tag:interview
+//
+// Terms next to each other are implicitly joined with AND.  Bare
+// words are free text and match the title, description, filename
+// and tags; a trailing '*' matches words by prefix.  A leading '-'
+// is shorthand for NOT.
+
+// queryNode is a node of a parsed query.
+type queryNode interface {
+	match(e *LibraryEntry) bool
+	String() string
+}
+
+type allNode struct{}
+
+func (allNode) match(e *LibraryEntry) bool { return true }
+func (allNode) String() string             { return "*" }
+
+type andNode struct {
+	left, right queryNode
+}
+
+func (n *andNode) match(e *LibraryEntry) bool { return n.left.match(e) && n.right.match(e) }
+func (n *andNode) String() string             { return "(" + n.left.String() + " AND " + n.right.String() + ")" }
+
+type orNode struct {
+	left, right queryNode
+}
+
+func (n *orNode) match(e *LibraryEntry) bool { return n.left.match(e) || n.right.match(e) }
+func (n *orNode) String() string             { return "(" + n.left.String() + " OR " + n.right.String() + ")" }
+
+type notNode struct {
+	inner queryNode
+}
+
+func (n *notNode) match(e *LibraryEntry) bool { return !n.inner.match(e) }
+func (n *notNode) String() string             { return "NOT " + n.inner.String() }
+
+// textNode is a free text term.  It is matched against the text
+// index, so it matches any form of the words that share a stem.  A
+// term with several words, such as a quoted phrase, requires all of
+// the words to be present.
+type textNode struct {
+	text   string
+	prefix bool
+
+	// groups holds the stems for each word, looked up on first use.
+	groups [][]string
+}
+
+func (n *textNode) stems() [][]string {
+	if n.groups == nil {
+		n.groups = index.expand(n.text, n.prefix)
+	}
+	return n.groups
+}
+
+func (n *textNode) match(e *LibraryEntry) bool {
+	groups := n.stems()
+	if len(groups) == 0 {
+		return false
+	}
+	for _, g := range groups {
+		if !index.contains(e.Filename, g) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *textNode) String() string {
+	if n.prefix {
+		return fmt.Sprintf("%q*", n.text)
+	}
+	return fmt.Sprintf("%q", n.text)
+}
+
+// textTerms returns the free text terms of a query that contribute
+// to ranking.  Terms under a NOT are excluded.
+func textTerms(n queryNode) []*textNode {
+	switch n := n.(type) {
+	case *textNode:
+		return []*textNode{n}
+	case *andNode:
+		return append(textTerms(n.left), textTerms(n.right)...)
+	case *orNode:
+		return append(textTerms(n.left), textTerms(n.right)...)
+	}
+	return nil
+}
+
+// tagNode matches entries that carry a tag or any tag below it in
+// the hierarchy, either on the whole entry or on one of its
+// annotations.  A tag nobody uses is taken to be a typo and matches
+// the tags close to it instead.
+type tagNode struct {
+	tag string
+
+	// alts holds the tags to match, looked up on first use.
+	alts []string
+}
+
+func (n *tagNode) match(e *LibraryEntry) bool {
+	for _, t := range e.allTags() {
+		if n.matchTag(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchTag reports if a single tag satisfies the node.
+func (n *tagNode) matchTag(t string) bool {
+	if n.alts == nil {
+		n.alts = index.tagAlternatives(strings.TrimSpace(n.tag))
+	}
+	for _, a := range n.alts {
+		if tagMatches(t, a) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *tagNode) String() string { return fmt.Sprintf("tag:%q", n.tag) }
+
+// namespaceNode matches entries carrying a value in a tag namespace,
+// or any value at all for the value "*".  Like tags, a value matches
+// the values below it in the hierarchy.
+type namespaceNode struct {
+	ns    string
+	value string
+}
+
+func (n *namespaceNode) match(e *LibraryEntry) bool {
+	for _, t := range e.allTags() {
+		if n.matchTag(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchTag reports if a single tag satisfies the node.
+func (n *namespaceNode) matchTag(t string) bool {
+	ns, v := namespaces.split(t)
+	if ns == nil || ns.Name != n.ns {
+		return false
+	}
+	return n.value == "*" || tagMatches(v, n.value)
+}
+
+func (n *namespaceNode) String() string { return fmt.Sprintf("%s:%q", n.ns, n.value) }
+
+// stringNode compares one of the string fields of an entry.
+type stringNode struct {
+	field string
+	op    string
+	value string
+}
+
+var stringFields = map[string]func(*LibraryEntry) string{
+	"title":       func(e *LibraryEntry) string { return e.Title },
+	"description": func(e *LibraryEntry) string { return e.Description },
+	"filename":    func(e *LibraryEntry) string { return e.Filename },
+	"kind":        entryKind,
+}
+
+func (n *stringNode) match(e *LibraryEntry) bool {
+	v := stringFields[n.field](e)
+	switch n.op {
+	case ":":
+		return strings.Contains(strings.ToLower(v), strings.ToLower(n.value))
+	case "=":
+		return strings.EqualFold(v, n.value)
+	case "!=":
+		return !strings.EqualFold(v, n.value)
+	}
+	return false
+}
+
+func (n *stringNode) String() string { return fmt.Sprintf("%s%s%q", n.field, n.op, n.value) }
+
+// dateNode compares a date of an entry against the half open range
+// [from, to) described by a possibly partial date.
+type dateNode struct {
+	field    string
+	op       string
+	from, to time.Time
+	raw      string
+}
+
+var dateFields = map[string]func(*LibraryEntry) time.Time{
+	"date":     func(e *LibraryEntry) time.Time { return e.Date.Time },
+	"modified": func(e *LibraryEntry) time.Time { return e.Modified },
+}
+
+// dateValue returns the named date of an entry, which is either one
+// of the built in dates or a custom date field.
+func dateValue(field string, e *LibraryEntry) time.Time {
+	if get, ok := dateFields[field]; ok {
+		return get(e)
+	}
+	d, _ := time.Parse("2006-01-02", e.Extra[field])
+	return d
+}
+
+func (n *dateNode) match(e *LibraryEntry) bool {
+	d := dateValue(n.field, e)
+	if d.IsZero() {
+		return false
+	}
+	switch n.op {
+	case ":", "=":
+		return !d.Before(n.from) && d.Before(n.to)
+	case "!=":
+		return d.Before(n.from) || !d.Before(n.to)
+	case "<":
+		return d.Before(n.from)
+	case "<=":
+		return d.Before(n.to)
+	case ">":
+		return !d.Before(n.to)
+	case ">=":
+		return !d.Before(n.from)
+	}
+	return false
+}
+
+func (n *dateNode) String() string { return n.field + n.op + n.raw }
+
+// missingNode matches entries lacking a field.
+type missingNode struct {
+	field string
+}
+
+func (n *missingNode) match(e *LibraryEntry) bool {
+	if check, ok := missingChecks[n.field]; ok {
+		return check(e)
+	}
+	return e.Extra[n.field] == ""
+}
+
+func (n *missingNode) String() string { return "missing:" + n.field }
+
+// customNode compares the value of a custom field.  Dates are
+// handled by dateNode.
+type customNode struct {
+	field *Field
+	op    string
+	value string
+	num   float64
+}
+
+func (n *customNode) match(e *LibraryEntry) bool {
+	v, ok := e.Extra[n.field.Name]
+	if !ok {
+		return false
+	}
+	if n.field.Type == fieldNumber {
+		x, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return false
+		}
+		switch n.op {
+		case ":", "=":
+			return x == n.num
+		case "!=":
+			return x != n.num
+		case "<":
+			return x < n.num
+		case "<=":
+			return x <= n.num
+		case ">":
+			return x > n.num
+		case ">=":
+			return x >= n.num
+		}
+		return false
+	}
+	switch n.op {
+	case ":":
+		if n.field.Type == fieldString || n.field.Type == fieldURL {
+			return strings.Contains(strings.ToLower(v), strings.ToLower(n.value))
+		}
+		return strings.EqualFold(v, n.value)
+	case "=":
+		return strings.EqualFold(v, n.value)
+	case "!=":
+		return !strings.EqualFold(v, n.value)
+	}
+	return false
+}
+
+func (n *customNode) String() string { return fmt.Sprintf("%s%s%q", n.field.Name, n.op, n.value) }
+
+// queryError is returned for queries that can't be parsed.
+type queryError struct {
+	pos int
+	msg string
+}
+
+func (e *queryError) Error() string {
+	return fmt.Sprintf("query error at position %d: %s", e.pos+1, e.msg)
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokLParen
+	tokRParen
+	tokAnd
+	tokOr
+	tokNot
+	tokTerm
+)
+
+// token is a single lexical element of a query.  Terms carry the
+// optional field and operator along with their value.
+type token struct {
+	kind   tokenKind
+	pos    int
+	field  string
+	op     string
+	value  string
+	quoted bool
+}
+
+var queryOps = []string{"!=", "<=", ">=", ":", "=", "<", ">"}
+
+type queryLexer struct {
+	in  []rune
+	pos int
+}
+
+func (l *queryLexer) next() (token, error) {
+	for l.pos < len(l.in) && unicode.IsSpace(l.in[l.pos]) {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos >= len(l.in) {
+		return token{kind: tokEOF, pos: start}, nil
+	}
+
+	switch l.in[l.pos] {
+	case '(':
+		l.pos++
+		return token{kind: tokLParen, pos: start}, nil
+	case ')':
+		l.pos++
+		return token{kind: tokRParen, pos: start}, nil
+	case '-':
+		l.pos++
+		return token{kind: tokNot, pos: start}, nil
+	}
+
+	t := token{kind: tokTerm, pos: start}
+
+	// Look for a field name followed by an operator.
+	end := l.pos
+	for end < len(l.in) && (unicode.IsLetter(l.in[end]) || l.in[end] == '_') {
+		end++
+	}
+	if end > l.pos {
+		rest := string(l.in[end:])
+		for _, op := range queryOps {
+			if strings.HasPrefix(rest, op) {
+				t.field = strings.ToLower(string(l.in[l.pos:end]))
+				t.op = op
+				l.pos = end + len(op)
+				break
+			}
+		}
+	}
+
+	v, quoted, err := l.value()
+	if err != nil {
+		return t, err
+	}
+	t.value = v
+	t.quoted = quoted
+
+	if t.field == "" && !quoted {
+		switch strings.ToLower(v) {
+		case "and":
+			t.kind = tokAnd
+		case "or":
+			t.kind = tokOr
+		case "not":
+			t.kind = tokNot
+		}
+	}
+	return t, nil
+}
+
+// value reads either a quoted string or a run of characters up to
+// the next space or parenthesis.
+func (l *queryLexer) value() (string, bool, error) {
+	if l.pos < len(l.in) && l.in[l.pos] == '"' {
+		start := l.pos
+		l.pos++
+		var sb strings.Builder
+		for l.pos < len(l.in) {
+			c := l.in[l.pos]
+			l.pos++
+			switch {
+			case c == '\\' && l.pos < len(l.in):
+				sb.WriteRune(l.in[l.pos])
+				l.pos++
+			case c == '"':
+				return sb.String(), true, nil
+			default:
+				sb.WriteRune(c)
+			}
+		}
+		return "", false, &queryError{start, "unterminated quote"}
+	}
+
+	start := l.pos
+	for l.pos < len(l.in) {
+		c := l.in[l.pos]
+		if unicode.IsSpace(c) || c == '(' || c == ')' {
+			break
+		}
+		l.pos++
+	}
+	return string(l.in[start:l.pos]), false, nil
+}
+
+type queryParser struct {
+	lex *queryLexer
+	tok token
+}
+
+// parseQuery parses a query string into an AST.  The empty query
+// matches everything.
+func parseQuery(s string) (queryNode, error) {
+	p := &queryParser{lex: &queryLexer{in: []rune(s)}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokEOF {
+		return allNode{}, nil
+	}
+
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, &queryError{p.tok.pos, "unexpected input"}
+	}
+	return n, nil
+}
+
+func (p *queryParser) advance() error {
+	t, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = t
+	return nil
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOr {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left, right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.tok.kind {
+		case tokAnd:
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+		case tokNot, tokLParen, tokTerm:
+			// implicit AND
+		default:
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left, right}
+	}
+}
+
+func (p *queryParser) parseUnary() (queryNode, error) {
+	if p.tok.kind == tokNot {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{inner}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	switch p.tok.kind {
+	case tokLParen:
+		start := p.tok.pos
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokRParen {
+			return nil, &queryError{start, "unbalanced parenthesis"}
+		}
+		return n, p.advance()
+	case tokTerm:
+		n, err := p.term(p.tok)
+		if err != nil {
+			return nil, err
+		}
+		return n, p.advance()
+	case tokEOF:
+		return nil, &queryError{p.tok.pos, "unexpected end of query"}
+	}
+	return nil, &queryError{p.tok.pos, "unexpected operator"}
+}
+
+// term builds the node for a single search term.
+func (p *queryParser) term(t token) (queryNode, error) {
+	if t.field == "" {
+		if t.value == "" {
+			return nil, &queryError{t.pos, "empty term"}
+		}
+		if !t.quoted && strings.HasSuffix(t.value, "*") {
+			return &textNode{text: strings.TrimSuffix(t.value, "*"), prefix: true}, nil
+		}
+		return &textNode{text: t.value}, nil
+	}
+	if t.value == "" {
+		return nil, &queryError{t.pos, fmt.Sprintf("missing value for %s", t.field)}
+	}
+
+	switch t.field {
+	case "tag":
+		if t.op == "!=" {
+			return &notNode{&tagNode{tag: t.value}}, nil
+		}
+		if t.op != ":" && t.op != "=" {
+			return nil, &queryError{t.pos, "tags can only be compared with ':', '=' or '!='"}
+		}
+		return &tagNode{tag: t.value}, nil
+	case "missing":
+		if t.op != ":" {
+			return nil, &queryError{t.pos, "missing must be written as missing:field"}
+		}
+		f := strings.ToLower(t.value)
+		if _, ok := missingChecks[f]; !ok && customFields.get(f) == nil {
+			return nil, &queryError{t.pos, fmt.Sprintf("unknown field %q", t.value)}
+		}
+		return &missingNode{f}, nil
+	case "date", "modified":
+		return parseDateTerm(t)
+	}
+
+	if _, ok := stringFields[t.field]; ok {
+		switch t.op {
+		case ":", "=", "!=":
+			return &stringNode{t.field, t.op, t.value}, nil
+		}
+		return nil, &queryError{t.pos, fmt.Sprintf("%s can't be compared with %q", t.field, t.op)}
+	}
+	if f := customFields.get(t.field); f != nil {
+		return parseCustomTerm(f, t)
+	}
+	if ns := namespaces.get(t.field); ns != nil {
+		node := &namespaceNode{ns: ns.Name, value: strings.TrimSuffix(strings.TrimSpace(t.value), tagSeparator+"*")}
+		switch t.op {
+		case ":", "=":
+			return node, nil
+		case "!=":
+			return &notNode{node}, nil
+		}
+		return nil, &queryError{t.pos, fmt.Sprintf("%s can only be compared with ':', '=' or '!='", t.field)}
+	}
+	return nil, &queryError{t.pos, fmt.Sprintf("unknown field %q", t.field)}
+}
+
+// isQueryField reports if name is one of the built in query fields.
+func isQueryField(name string) bool {
+	switch name {
+	case "tag", "missing", "date", "modified":
+		return true
+	}
+	_, ok := stringFields[name]
+	return ok
+}
+
+// parseCustomTerm handles comparisons of custom fields.  Numbers and
+// dates can be ordered, the other types only compared for equality,
+// and strings and URLs also searched with ':' for a substring.
+func parseCustomTerm(f *Field, t token) (queryNode, error) {
+	if f.Type == fieldDate {
+		return parseDateTerm(t)
+	}
+	n := &customNode{field: f, op: t.op, value: t.value}
+	if f.Type == fieldNumber {
+		num, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, &queryError{t.pos, fmt.Sprintf("%s must be compared with a number", f.Name)}
+		}
+		n.num = num
+		return n, nil
+	}
+	switch t.op {
+	case ":", "=", "!=":
+	default:
+		return nil, &queryError{t.pos, fmt.Sprintf("%s can't be compared with %q", f.Name, t.op)}
+	}
+	if f.Type == fieldBoolean {
+		b, err := strconv.ParseBool(t.value)
+		if err != nil {
+			return nil, &queryError{t.pos, fmt.Sprintf("%s must be compared with true or false", f.Name)}
+		}
+		n.value = strconv.FormatBool(b)
+	}
+	return n, nil
+}
+
+// parseDateTerm handles date comparisons.  Dates may be given as a
+// year, a month or a full day, or relative to today, and ranges may
+// be written as date:2017-01..2017-06 where both ends are inclusive.
+func parseDateTerm(t token) (queryNode, error) {
+	if i := strings.Index(t.value, ".."); i >= 0 {
+		if t.op != ":" && t.op != "=" {
+			return nil, &queryError{t.pos, "date ranges must be written as " + t.field + ":from..to"}
+		}
+		from, _, err := parsePartialDate(t.value[:i])
+		if err != nil {
+			return nil, &queryError{t.pos, err.Error()}
+		}
+		_, to, err := parsePartialDate(t.value[i+2:])
+		if err != nil {
+			return nil, &queryError{t.pos, err.Error()}
+		}
+		return &dateNode{field: t.field, op: t.op, from: from, to: to, raw: t.value}, nil
+	}
+
+	from, to, err := parsePartialDate(t.value)
+	if err != nil {
+		return nil, &queryError{t.pos, err.Error()}
+	}
+	return &dateNode{field: t.field, op: t.op, from: from, to: to, raw: t.value}, nil
+}
+
+// parsePartialDate returns the half open range covered by a year,
+// month or day.  The day may also be given relative to today, as
+// today, yesterday or a number of days, weeks, months or years ago
+// such as -7d or -2w.
+func parsePartialDate(s string) (time.Time, time.Time, error) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	switch strings.ToLower(s) {
+	case "today":
+		return today, today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), today, nil
+	}
+	if len(s) > 2 && s[0] == '-' {
+		if n, err := strconv.Atoi(s[1 : len(s)-1]); err == nil {
+			var d time.Time
+			switch s[len(s)-1] {
+			case 'd':
+				d = today.AddDate(0, 0, -n)
+			case 'w':
+				d = today.AddDate(0, 0, -7*n)
+			case 'm':
+				d = today.AddDate(0, -n, 0)
+			case 'y':
+				d = today.AddDate(-n, 0, 0)
+			default:
+				return time.Time{}, time.Time{}, fmt.Errorf("bad relative date %q", s)
+			}
+			return d, d.AddDate(0, 0, 1), nil
+		}
+	}
+
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, t.AddDate(0, 0, 1), nil
+	}
+	if t, err := time.Parse("2006-01", s); err == nil {
+		return t, t.AddDate(0, 1, 0), nil
+	}
+	if t, err := time.Parse("2006", s); err == nil {
+		return t, t.AddDate(1, 0, 0), nil
+	}
+	return time.Time{}, time.Time{}, fmt.Errorf("bad date %q", s)
+}
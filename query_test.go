@@ -0,0 +1,135 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", "*"},
+		{"cat dog", `("cat" AND "dog")`},
+		{"cat OR dog -bird", `("cat" OR ("dog" AND NOT "bird"))`},
+		{"(a or b) c", `(("a" OR "b") AND "c")`},
+		{"not cat", `NOT "cat"`},
+		{`"big cat" TITLE=zoo`, `("big cat" AND title="zoo")`},
+		{"cat*", `"cat"*`},
+		{`"cat*"`, `"cat*"`},
+		{"tag:pets/cats", `tag:"pets/cats"`},
+		{"tag!=pets", `NOT tag:"pets"`},
+		{"missing:Tags", "missing:tags"},
+		{"date:2017-01..2017-06", "date:2017-01..2017-06"},
+		{"modified>=-7d", "modified>=-7d"},
+	}
+	for _, tt := range tests {
+		n, err := parseQuery(tt.in)
+		if err != nil {
+			t.Errorf("parseQuery(%q): %s", tt.in, err)
+			continue
+		}
+		if got := n.String(); got != tt.want {
+			t.Errorf("parseQuery(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	for _, in := range []string{
+		"(cat",
+		"cat)",
+		`"open`,
+		"-",
+		"cat OR",
+		"title:",
+		"tag<x",
+		"title>x",
+		"bogus:x",
+		"missing:bogus",
+		"date:2017-13",
+		"date>2017..2018",
+		"date:-3q",
+	} {
+		if n, err := parseQuery(in); err == nil {
+			t.Errorf("parseQuery(%q) = %s, want an error", in, n)
+		}
+	}
+}
+
+func TestParsePartialDate(t *testing.T) {
+	day := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	tests := []struct {
+		in       string
+		from, to time.Time
+	}{
+		{"2017", day("2017-01-01"), day("2018-01-01")},
+		{"2017-02", day("2017-02-01"), day("2017-03-01")},
+		{"2017-02-28", day("2017-02-28"), day("2017-03-01")},
+		{"Today", today, today.AddDate(0, 0, 1)},
+		{"yesterday", today.AddDate(0, 0, -1), today},
+		{"-7d", today.AddDate(0, 0, -7), today.AddDate(0, 0, -6)},
+		{"-2w", today.AddDate(0, 0, -14), today.AddDate(0, 0, -13)},
+		{"-1m", today.AddDate(0, -1, 0), today.AddDate(0, -1, 1)},
+		{"-1y", today.AddDate(-1, 0, 0), today.AddDate(-1, 0, 1)},
+	}
+	for _, tt := range tests {
+		from, to, err := parsePartialDate(tt.in)
+		if err != nil {
+			t.Errorf("parsePartialDate(%q): %s", tt.in, err)
+			continue
+		}
+		if !from.Equal(tt.from) || !to.Equal(tt.to) {
+			t.Errorf("parsePartialDate(%q) = %s..%s, want %s..%s", tt.in, from, to, tt.from, tt.to)
+		}
+	}
+	for _, in := range []string{"", "soon", "-3q", "-d", "2017-1-1"} {
+		if _, _, err := parsePartialDate(in); err == nil {
+			t.Errorf("parsePartialDate(%q) gave no error", in)
+		}
+	}
+}
+
+func TestDateQueries(t *testing.T) {
+	on := func(s string) *LibraryEntry {
+		d, _ := time.Parse("2006-01-02", s)
+		return &LibraryEntry{Date: vTime{d}}
+	}
+	tests := []struct {
+		query string
+		date  string
+		want  bool
+	}{
+		{"date:2017-01..2017-06", "2017-01-01", true},
+		{"date:2017-01..2017-06", "2017-06-30", true},
+		{"date:2017-01..2017-06", "2017-07-01", false},
+		{"date<2017", "2016-12-31", true},
+		{"date<2017", "2017-01-01", false},
+		{"date<=2017", "2017-12-31", true},
+		{"date>2017", "2017-12-31", false},
+		{"date>2017", "2018-01-01", true},
+		{"date>=2017-06", "2017-06-01", true},
+		{"date!=2017", "2017-05-05", false},
+		{"date!=2017", "2018-05-05", true},
+	}
+	for _, tt := range tests {
+		n, err := parseQuery(tt.query)
+		if err != nil {
+			t.Fatalf("parseQuery(%q): %s", tt.query, err)
+		}
+		if got := n.match(on(tt.date)); got != tt.want {
+			t.Errorf("%s on %s = %v, want %v", tt.query, tt.date, got, tt.want)
+		}
+	}
+	n, _ := parseQuery("date:2017")
+	if n.match(&LibraryEntry{}) {
+		t.Error("an entry without a date matched a date query")
+	}
+}
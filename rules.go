@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// TagRules rewrite the tags of an entry when it is saved.  Aliases
+// replace a tag with its canonical name and implications add tags
+// that always go along with another, so tagging something standup
+// can also tag it meeting.
+type TagRules struct {
+	// Aliases maps a lower cased alias to its canonical tag.
+	Aliases map[string]string
+
+	// Implies maps a lower cased canonical tag to the tags it
+	// implies.
+	Implies map[string][]string
+}
+
+var tagRules = newTagRules()
+
+func newTagRules() *TagRules {
+	return &TagRules{
+		Aliases: make(map[string]string),
+		Implies: make(map[string][]string),
+	}
+}
+
+// canonical resolves aliases for a tag.  An alias for a tag higher
+// up the hierarchy also applies to the tags below it, so with loc
+// aliased to location the tag loc/lab becomes location/lab.
+func (r *TagRules) canonical(tag string) string {
+	lt := strings.ToLower(tag)
+	if c, ok := r.Aliases[lt]; ok {
+		return c
+	}
+	for n := strings.Count(tag, tagSeparator); n > 0; n-- {
+		above, below, _ := splitTagLevels(tag, n)
+		if c, ok := r.Aliases[strings.ToLower(above)]; ok {
+			return c + tagSeparator + below
+		}
+	}
+	return tag
+}
+
+// apply returns the tags with aliases resolved and implied tags
+// added.  Blank and duplicate tags are dropped.
+func (r *TagRules) apply(tags []string) []string {
+	out := []string{}
+	seen := make(map[string]bool)
+	var add func(t string)
+	add = func(t string) {
+		t = r.canonical(strings.TrimSpace(t))
+		if t == "" || seen[strings.ToLower(t)] {
+			return
+		}
+		seen[strings.ToLower(t)] = true
+		out = append(out, t)
+		for _, i := range r.Implies[strings.ToLower(t)] {
+			add(i)
+		}
+	}
+	for _, t := range tags {
+		add(t)
+	}
+	return out
+}
+
+// parseTagRules reads rules written one per line as either
+//
+//     alias = canonical
+//     tag -> implied, other implied
+//
+// Blank lines and lines starting with # are ignored.
+func parseTagRules(text string) (*TagRules, error) {
+	r := newTagRules()
+	sc := bufio.NewScanner(strings.NewReader(text))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if i := strings.Index(line, "->"); i >= 0 {
+			tag := strings.ToLower(strings.TrimSpace(line[:i]))
+			implied := splitValues([]string{line[i+2:]})
+			if tag == "" || len(implied) == 0 {
+				return nil, fmt.Errorf("line %d: implications are written as tag -> implied", n)
+			}
+			r.Implies[tag] = append(r.Implies[tag], implied...)
+			continue
+		}
+
+		if i := strings.Index(line, "="); i >= 0 {
+			alias := strings.ToLower(strings.TrimSpace(line[:i]))
+			canon := strings.TrimSpace(line[i+1:])
+			if alias == "" || canon == "" {
+				return nil, fmt.Errorf("line %d: aliases are written as alias = canonical", n)
+			}
+			if _, ok := r.Aliases[alias]; ok {
+				return nil, fmt.Errorf("line %d: %q is already an alias", n, alias)
+			}
+			r.Aliases[alias] = canon
+			continue
+		}
+
+		return nil, fmt.Errorf("line %d: expected alias = canonical or tag -> implied", n)
+	}
+
+	// Aliases must point at a canonical tag, not at another alias,
+	// or the rules would depend on the order they are applied in.
+	for alias, canon := range r.Aliases {
+		if _, ok := r.Aliases[strings.ToLower(canon)]; ok {
+			return nil, fmt.Errorf("alias %q points at %q which is itself an alias", alias, canon)
+		}
+	}
+	for tag := range r.Implies {
+		if _, ok := r.Aliases[tag]; ok {
+			return nil, fmt.Errorf("%q is an alias, implications must use the canonical tag", tag)
+		}
+	}
+	return r, nil
+}
+
+// String writes the rules in the format parseTagRules reads.
+func (r *TagRules) String() string {
+	var lines []string
+	for alias, canon := range r.Aliases {
+		lines = append(lines, alias+" = "+canon)
+	}
+	sort.Strings(lines)
+
+	var implies []string
+	for tag, implied := range r.Implies {
+		implies = append(implies, tag+" -> "+strings.Join(implied, ", "))
+	}
+	sort.Strings(implies)
+
+	return strings.Join(append(lines, implies...), "\n")
+}
+
+func rulesHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s := struct {
+		Rules string
+		Error string
+	}{}
+
+	if r.Method == http.MethodPost {
+		s.Rules = r.FormValue("rules")
+		rules, err := parseTagRules(s.Rules)
+		if err != nil {
+			if wantJSON(r) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			s.Error = err.Error()
+		} else {
+			log.Printf("Updating tag rules: %d aliases, %d implications", len(rules.Aliases), len(rules.Implies))
+			libraryLock.Lock()
+			tagRules = rules
+			dbDirty = true
+			libraryLock.Unlock()
+		}
+	}
+
+	libraryLock.RLock()
+	rules := tagRules
+	libraryLock.RUnlock()
+	if s.Error == "" {
+		s.Rules = rules.String()
+	}
+
+	if wantJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(rules); err != nil {
+			log.Printf("rulesHandler: encode error: %s", err)
+		}
+		return
+	}
+
+	err := ruleTmpl.ExecuteTemplate(w, "layout", s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// rulesApplyHandler applies the current rules to every entry in the
+// library, as an operation that can be previewed and undone like
+// the other bulk tag changes.
+func rulesApplyHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "applying rules requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	op := &TagOp{Kind: "rules"}
+	dryRun := r.FormValue("dry_run") != ""
+	applyTagOp(op, dryRun)
+	renderTagOpResult(w, r, &tagOpResult{Op: op, DryRun: dryRun})
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagRulesCanonical(t *testing.T) {
+	r := newTagRules()
+	r.Aliases["loc"] = "location"
+	r.Aliases["loc/hq"] = "location/headquarters"
+	r.Aliases["k"] = "kilo"
+	tests := []struct {
+		tag, want string
+	}{
+		{"loc", "location"},
+		{"LOC", "location"},
+		{"loc/lab", "location/lab"},
+		{"Loc/Lab/Bay-2", "location/Lab/Bay-2"},
+		{"loc/hq/floor-3", "location/headquarters/floor-3"},
+		{"local", "local"},
+		{"other/loc", "other/loc"},
+		// The Kelvin sign lower cases to a one byte k.
+		{"\u212A/Bay", "kilo/Bay"},
+		{"\u212A\u212A/x/\u212A", "\u212A\u212A/x/\u212A"},
+		{"/loc", "/loc"},
+	}
+	for _, tt := range tests {
+		if got := r.canonical(tt.tag); got != tt.want {
+			t.Errorf("canonical(%q) = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestTagRulesApply(t *testing.T) {
+	r, err := parseTagRules("loc = location\nstandup -> meeting\nmeeting -> work, calendar\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := r.apply([]string{"standup", " ", "loc/lab", "Meeting", "location/lab"})
+	want := []string{"standup", "meeting", "work", "calendar", "location/lab"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("apply = %q, want %q", got, want)
+	}
+}
+
+func TestParseTagRules(t *testing.T) {
+	r, err := parseTagRules("# team rules\n\nLoc = location\nstandup -> meeting\nstandup -> daily\nMeeting -> work, , calendar\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"loc": "location"}; !reflect.DeepEqual(r.Aliases, want) {
+		t.Errorf("aliases %v, want %v", r.Aliases, want)
+	}
+	want := map[string][]string{"standup": {"meeting", "daily"}, "meeting": {"work", "calendar"}}
+	if !reflect.DeepEqual(r.Implies, want) {
+		t.Errorf("implications %v, want %v", r.Implies, want)
+	}
+	text := "loc = location\nmeeting -> work, calendar\nstandup -> meeting, daily"
+	if got := r.String(); got != text {
+		t.Errorf("String() = %q, want %q", got, text)
+	}
+	if again, err := parseTagRules(r.String()); err != nil || !reflect.DeepEqual(again, r) {
+		t.Errorf("rules don't survive being written out: %v, %v", again, err)
+	}
+
+	for _, in := range []string{
+		"loc",
+		"= location",
+		"loc =",
+		"-> meeting",
+		"standup ->",
+		"loc = location\nLOC = place",
+		"loc = location\nlocation = place",
+		"loc = location\nloc -> place",
+	} {
+		if _, err := parseTagRules(in); err == nil {
+			t.Errorf("parseTagRules(%q) gave no error", in)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SavedSearch is a named query whose membership is worked out
+// whenever it is looked at, so it always reflects the current state
+// of the library.
+type SavedSearch struct {
+	Name    string
+	Query   string
+	Created time.Time
+}
+
+// savedSearchCount is a saved search along with how many entries
+// currently match it.
+type savedSearchCount struct {
+	Name  string
+	Query string
+	Link  string
+	Count int
+}
+
+var (
+	searches map[string]*SavedSearch
+
+	// The counts are cached until the library or the saved
+	// searches change, or the day does since queries may use
+	// dates relative to today.
+	savedCountLock  sync.Mutex
+	savedCounts     []savedSearchCount
+	savedCountKey   = savedCountState{Library: -1}
+	searchesVersion int
+)
+
+// savedCountState is what the cached counts depend on.
+type savedCountState struct {
+	Library  int
+	Searches int
+	Day      string
+}
+
+// savedSearchList returns the saved searches sorted by name along
+// with their current counts.  Searches that no longer parse are
+// reported with a count of -1.
+func savedSearchList() []savedSearchCount {
+	savedCountLock.Lock()
+	defer savedCountLock.Unlock()
+	libraryLock.RLock()
+	defer libraryLock.RUnlock()
+
+	// Relative dates are worked out in UTC, see parsePartialDate.
+	key := savedCountState{libraryVersion, searchesVersion, time.Now().UTC().Format("2006-01-02")}
+	if key == savedCountKey {
+		return savedCounts
+	}
+
+	counts := make([]savedSearchCount, 0, len(searches))
+	for _, s := range searches {
+		c := savedSearchCount{
+			Name:  s.Name,
+			Query: s.Query,
+			Link:  "/search?" + url.Values{"q": {s.Query}}.Encode(),
+			Count: -1,
+		}
+		if n, err := parseQuery(s.Query); err == nil {
+			c.Count = 0
+			for _, e := range library {
+				if n.match(e) {
+					c.Count++
+				}
+			}
+		}
+		counts = append(counts, c)
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Name < counts[j].Name })
+
+	savedCounts = counts
+	savedCountKey = key
+	return counts
+}
+
+func savedSearchesHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(savedSearchList()); err != nil {
+		log.Printf("savedSearchesHandler: encode error: %s", err)
+	}
+}
+
+func saveSearchHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "saving a search requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	name := strings.TrimSpace(r.FormValue("name"))
+	q := strings.TrimSpace(r.FormValue("q"))
+	if name == "" {
+		http.Error(w, "a saved search needs a name", http.StatusBadRequest)
+		return
+	}
+	if _, err := parseQuery(q); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	log.Printf("Saving search %q as %q", q, name)
+	libraryLock.Lock()
+	searches[name] = &SavedSearch{
+		Name:    name,
+		Query:   q,
+		Created: time.Now(),
+	}
+	searchesVersion++
+	dbDirty = true
+	libraryLock.Unlock()
+
+	http.Redirect(w, r, "/search?"+url.Values{"q": {q}}.Encode(), http.StatusSeeOther)
+}
+
+func deleteSearchHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "deleting a search requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	name := r.FormValue("name")
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
+	if _, ok := searches[name]; !ok {
+		http.Error(w, "no such saved search", http.StatusNotFound)
+		return
+	}
+
+	log.Printf("Deleting saved search %q", name)
+	delete(searches, name)
+	searchesVersion++
+	dbDirty = true
+
+	http.Redirect(w, r, "/search", http.StatusSeeOther)
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSavedSearchCountsFollowTheDay(t *testing.T) {
+	defer withLibrary(
+		&LibraryEntry{Filename: "new.mp4", Modified: time.Now()},
+		&LibraryEntry{Filename: "old.mp4", Modified: time.Now().AddDate(0, 0, -30)},
+	)()
+	oldSearches := searches
+	defer func() { searches = oldSearches }()
+	searches = map[string]*SavedSearch{
+		"recent": {Name: "recent", Query: "modified>=-7d"},
+	}
+	searchesVersion++
+
+	if got := savedSearchList(); len(got) != 1 || got[0].Count != 1 {
+		t.Fatalf("savedSearchList() = %+v", got)
+	}
+
+	// Counts cached on another day are stale even if nothing was
+	// edited since.
+	savedCountLock.Lock()
+	savedCounts[0].Count = 42
+	savedCountKey.Day = "2000-01-01"
+	savedCountLock.Unlock()
+	if got := savedSearchList(); got[0].Count != 1 {
+		t.Errorf("count from another day reused: %+v", got)
+	}
+}
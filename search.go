@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// wantJSON reports if the client asked for a JSON response, either
+// with format=json or through the Accept header.
+func wantJSON(r *http.Request) bool {
+	if r.FormValue("format") == "json" {
+		return true
+	}
+	return strings.Contains(r.Header.Get("Accept"), "application/json")
+}
+
+// searchResult is an entry along with its highlighted text.
+type searchResult struct {
+	Entry       *LibraryEntry `json:"-"`
+	Title       []fragment
+	Description []fragment
+	Annotations []Annotation `json:",omitempty"`
+	Cues        []cueMatch   `json:",omitempty"`
+}
+
+// descriptionSnippet is roughly how many characters of the
+// description are shown around the first match.
+const descriptionSnippet = 200
+
+func searchHandler(w http.ResponseWriter, r *http.Request) {
+	s := struct {
+		*listPage
+		Query      *listQuery
+		Q          string
+		Kinds      []string
+		Error      string
+		NextLink   string
+		Suggestion string
+		Results    []searchResult
+	}{
+		Q: r.FormValue("q"),
+	}
+	if err := r.ParseForm(); err == nil {
+		s.Kinds = splitValues(r.Form["kind"])
+	}
+
+	q, err := parseListQuery(r)
+	if err == nil {
+		s.Query = q
+		libraryLock.RLock()
+		s.listPage, err = q.run(library)
+		libraryLock.RUnlock()
+	}
+	if err != nil {
+		if wantJSON(r) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		s.Error = err.Error()
+		s.listPage = &listPage{}
+	} else {
+		s.Suggestion = suggestQuery(q.Q, q.Search)
+		stems := queryStems(q.Search)
+		for _, e := range s.Entries {
+			title := e.Title
+			if title == "" {
+				title = e.Filename
+			}
+			s.Results = append(s.Results, searchResult{
+				Entry:       e,
+				Title:       highlight(title, stems, 0),
+				Description: highlight(e.Description, stems, descriptionSnippet),
+				Annotations: matchingAnnotations(e, q.Search, stems),
+				Cues:        matchingCues(e, stems),
+			})
+		}
+	}
+
+	if wantJSON(r) {
+		highlights := make(map[string]searchResult, len(s.Results))
+		for _, res := range s.Results {
+			highlights[res.Entry.Filename] = res
+		}
+		resp := struct {
+			Entries    interface{}
+			Total      int
+			Next       string `json:",omitempty"`
+			Suggestion string `json:",omitempty"`
+			Highlights map[string]searchResult
+		}{
+			Entries:    q.selectFields(s.Entries),
+			Total:      s.Total,
+			Next:       s.Next,
+			Suggestion: s.Suggestion,
+			Highlights: highlights,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			log.Printf("searchHandler: encode error: %s", err)
+		}
+		return
+	}
+
+	if s.Next != "" {
+		s.NextLink = "/search?" + q.values(s.Next).Encode()
+	}
+	err = srchTmpl.ExecuteTemplate(w, "layout", s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -1 +1,308 @@
 $(document).foundation()
+
+// tokenInput turns an element into a list of removable tag chips
+// with an input for adding more, completed from /tags/complete.  It
+// returns an object to get and set the tags.
+//
+// The options may give a namespace, whose values are then edited
+// without the namespace: prefix, a color for the chips and single to
+// only allow one value.
+function tokenInput(el, options) {
+    options = options || {};
+    var prefix = options.namespace ? options.namespace + ':' : '';
+    var tags = [];
+    var chips = document.createElement('span');
+    var input = document.createElement('input');
+    var list = document.createElement('ul');
+    var selected = -1;
+    var pending = null;
+
+    input.type = 'text';
+    input.setAttribute('autocomplete', 'off');
+    list.className = 'token-suggestions';
+    el.classList.add('token-input');
+    el.appendChild(chips);
+    el.appendChild(input);
+    el.appendChild(list);
+
+    function render() {
+        chips.innerHTML = '';
+        tags.forEach(function(tag, i) {
+            var chip = document.createElement('span');
+            chip.className = 'token label';
+            chip.textContent = tag;
+            if (options.color) {
+                chip.style.backgroundColor = options.color;
+            }
+            var close = document.createElement('a');
+            close.className = 'token-remove';
+            close.title = 'Remove';
+            close.textContent = '×';
+            close.onclick = function() {
+                tags.splice(i, 1);
+                render();
+            };
+            chip.appendChild(close);
+            chips.appendChild(chip);
+        });
+    }
+
+    function add(tag) {
+        tag = tag.trim();
+        var lower = tag.toLowerCase();
+        if (tag && !tags.some(function(t) { return t.toLowerCase() === lower; })) {
+            if (options.single) {
+                tags = [];
+            }
+            tags.push(tag);
+            render();
+        }
+        input.value = '';
+        hide();
+    }
+
+    function hide() {
+        list.innerHTML = '';
+        list.style.display = 'none';
+        selected = -1;
+    }
+
+    function highlight(i) {
+        var items = list.children;
+        if (items.length === 0) {
+            return;
+        }
+        selected = (i + items.length) % items.length;
+        for (var j = 0; j < items.length; j++) {
+            items[j].classList.toggle('selected', j === selected);
+        }
+    }
+
+    function complete() {
+        var typed = input.value.trim();
+        if (pending) {
+            pending.abort();
+        }
+        if (!typed) {
+            hide();
+            return;
+        }
+        var xhr = new XMLHttpRequest();
+        pending = xhr;
+        xhr.responseType = 'json';
+        xhr.onreadystatechange = function() {
+            if (xhr.readyState !== XMLHttpRequest.DONE || xhr.status !== 200) {
+                return;
+            }
+            pending = null;
+            hide();
+            var have = tags.map(function(t) { return t.toLowerCase(); });
+            (xhr.response || []).forEach(function(c) {
+                var tag = c.Tag;
+                if (prefix) {
+                    if (tag.toLowerCase().indexOf(prefix) !== 0) {
+                        return;
+                    }
+                    tag = tag.substring(prefix.length);
+                }
+                if (have.indexOf(tag.toLowerCase()) >= 0) {
+                    return;
+                }
+                var item = document.createElement('li');
+                item.textContent = tag;
+                var count = document.createElement('span');
+                count.className = 'badge secondary';
+                count.textContent = c.Count;
+                item.appendChild(count);
+                item.onmousedown = function(ev) {
+                    ev.preventDefault();
+                    add(tag);
+                };
+                list.appendChild(item);
+            });
+            if (list.children.length > 0) {
+                list.style.display = 'block';
+            }
+        };
+        xhr.open('GET', '/tags/complete?limit=10&prefix=' + encodeURIComponent(prefix + typed));
+        xhr.send();
+    }
+
+    input.addEventListener('input', function() {
+        if (input.value.indexOf(',') >= 0) {
+            input.value.split(',').forEach(add);
+            return;
+        }
+        complete();
+    });
+    input.addEventListener('keydown', function(ev) {
+        switch (ev.key) {
+        case 'ArrowDown':
+            highlight(selected + 1);
+            break;
+        case 'ArrowUp':
+            highlight(selected - 1);
+            break;
+        case 'Enter':
+        case 'Tab':
+            if (selected >= 0) {
+                add(list.children[selected].firstChild.textContent);
+            } else if (input.value.trim() && ev.key === 'Enter') {
+                add(input.value);
+            } else {
+                return;
+            }
+            break;
+        case 'Escape':
+            hide();
+            break;
+        case 'Backspace':
+            if (input.value === '' && tags.length > 0) {
+                tags.pop();
+                render();
+            }
+            return;
+        default:
+            return;
+        }
+        ev.preventDefault();
+    });
+    input.addEventListener('blur', function() {
+        if (input.value.trim()) {
+            add(input.value);
+        }
+        hide();
+    });
+    el.addEventListener('click', function(ev) {
+        if (ev.target === el || ev.target === chips) {
+            input.focus();
+        }
+    });
+    hide();
+
+    return {
+        tags: function() {
+            if (input.value.trim()) {
+                add(input.value);
+            }
+            return tags.slice();
+        },
+        setTags: function(t) {
+            tags = (t || []).filter(function(s) { return s.trim() !== ''; });
+            render();
+        }
+    };
+}
+
+// formatTime writes seconds as m:ss, or h:mm:ss for an hour or more.
+function formatTime(sec) {
+    var s = Math.floor(sec);
+    var pad = function(n) { return (n < 10 ? '0' : '') + n; };
+    if (s >= 3600) {
+        return Math.floor(s / 3600) + ':' + pad(Math.floor(s / 60) % 60) + ':' + pad(s % 60);
+    }
+    return pad(Math.floor(s / 60)) + ':' + pad(s % 60);
+}
+
+// parseTime reads a time written as seconds, m:ss or h:mm:ss and
+// returns NaN if it can't.
+function parseTime(text) {
+    var parts = text.trim().split(':');
+    if (parts.length > 3 || text.trim() === '') {
+        return NaN;
+    }
+    return parts.reduce(function(sec, p) {
+        return sec * 60 + (/^\d+(\.\d*)?$/.test(p) ? parseFloat(p) : NaN);
+    }, 0);
+}
+
+// Reveal the warnings on files whose type the browser can't play.
+document.querySelectorAll('[data-unplayable]').forEach(function(el) {
+    var probe = document.createElement('video');
+    if (probe.canPlayType(el.dataset.unplayable) === '') {
+        el.style.display = '';
+    }
+});
+
+// storyboards caches the storyboards fetched for hover previews,
+// with false for videos that have none yet.
+var storyboards = {};
+
+// loadStoryboard calls done with the storyboard of a video once it
+// is available.
+function loadStoryboard(file, done) {
+    if (file in storyboards) {
+        if (storyboards[file]) {
+            done(storyboards[file]);
+        }
+        return;
+    }
+    storyboards[file] = false;
+    var xhr = new XMLHttpRequest();
+    xhr.open('GET', '/storyboard?format=json&file=' + encodeURIComponent(file), true);
+    xhr.onreadystatechange = function() {
+        if (xhr.readyState === XMLHttpRequest.DONE) {
+            if (xhr.status === 200) {
+                storyboards[file] = JSON.parse(xhr.responseText);
+                done(storyboards[file]);
+            } else {
+                // Try again later, it may still be being made.
+                delete storyboards[file];
+            }
+        }
+    };
+    xhr.send();
+}
+
+// showStoryboardFrame makes el, width pixels wide, show the frame of
+// the storyboard nearest to t seconds.
+function showStoryboardFrame(el, file, sb, t, width) {
+    var i = Math.max(0, Math.min(sb.Count - 1, Math.floor(t / sb.Interval)));
+    var scale = width / sb.Width;
+    var rows = Math.ceil(sb.Count / sb.Columns);
+    el.style.width = width + 'px';
+    el.style.height = sb.Height * scale + 'px';
+    el.style.backgroundImage = 'url("/storyboard?file=' + encodeURIComponent(file) + '")';
+    el.style.backgroundSize = sb.Columns * sb.Width * scale + 'px ' + rows * sb.Height * scale + 'px';
+    el.style.backgroundPosition = -(i % sb.Columns) * sb.Width * scale + 'px ' +
+        -Math.floor(i / sb.Columns) * sb.Height * scale + 'px';
+}
+
+// Skim through videos by moving over their thumbnails.
+document.querySelectorAll('.list-preview[data-file]').forEach(function(el) {
+    var frame = el.querySelector('.storyboard-frame');
+    el.addEventListener('mousemove', function(e) {
+        loadStoryboard(el.dataset.file, function(sb) {
+            var r = el.getBoundingClientRect();
+            var at = (e.clientX - r.left) / r.width * sb.Count * sb.Interval;
+            showStoryboardFrame(frame, el.dataset.file, sb, at, r.width);
+            frame.style.display = 'block';
+        });
+    });
+    el.addEventListener('mouseleave', function() {
+        frame.style.display = 'none';
+    });
+});
+
+// waitForJob polls a background job until it finishes, calling done
+// if it worked.
+function waitForJob(job, done) {
+    if (job.Status === 'done') {
+        done(job);
+        return;
+    }
+    if (job.Status === 'failed' || job.Status === 'canceled') {
+        alert('Job #' + job.ID + ' ' + job.Status + (job.Error ? ':\n' + job.Error : ''));
+        return;
+    }
+    setTimeout(function() {
+        var xhr = new XMLHttpRequest();
+        xhr.open('GET', '/jobs?format=json&id=' + job.ID, true);
+        xhr.onreadystatechange = function() {
+            if (xhr.readyState === XMLHttpRequest.DONE && xhr.status === 200) {
+                waitForJob(JSON.parse(xhr.responseText), done);
+            }
+        };
+        xhr.send();
+    }, 1000);
+}
@@ -0,0 +1,244 @@
+package main
+
+import "strings"
+
+// stem reduces an English word to its stem using the Porter
+// stemming algorithm.  The input is expected to be lower case.
+// Words of two letters or less are returned unchanged.
+//
+// See https://tartarus.org/martin/PorterStemmer/def.txt for the
+// definition of the steps below.
+func stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			// Leave numbers and non-ASCII words alone.
+			return word
+		}
+	}
+
+	w := []byte(word)
+	w = stemStep1a(w)
+	w = stemStep1b(w)
+	w = stemStep1c(w)
+	w = stemStep2(w)
+	w = stemStep3(w)
+	w = stemStep4(w)
+	w = stemStep5(w)
+	return string(w)
+}
+
+// isConsonant reports if the letter at i is a consonant in the
+// Porter sense, where 'y' is a consonant when it follows a vowel.
+func isConsonant(w []byte, i int) bool {
+	switch w[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		if i == 0 {
+			return true
+		}
+		return !isConsonant(w, i-1)
+	}
+	return true
+}
+
+// measure counts the VC sequences in w, the m of the paper.
+func measure(w []byte) int {
+	n := 0
+	i := 0
+	for i < len(w) && isConsonant(w, i) {
+		i++
+	}
+	for i < len(w) {
+		for i < len(w) && !isConsonant(w, i) {
+			i++
+		}
+		if i >= len(w) {
+			break
+		}
+		for i < len(w) && isConsonant(w, i) {
+			i++
+		}
+		n++
+	}
+	return n
+}
+
+func hasVowel(w []byte) bool {
+	for i := range w {
+		if !isConsonant(w, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func endsDoubleConsonant(w []byte) bool {
+	n := len(w)
+	return n >= 2 && w[n-1] == w[n-2] && isConsonant(w, n-1)
+}
+
+// endsCVC reports if w ends consonant-vowel-consonant where the
+// final consonant is not w, x or y.
+func endsCVC(w []byte) bool {
+	n := len(w)
+	if n < 3 {
+		return false
+	}
+	if !isConsonant(w, n-3) || isConsonant(w, n-2) || !isConsonant(w, n-1) {
+		return false
+	}
+	switch w[n-1] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+func hasSuffix(w []byte, s string) bool {
+	return strings.HasSuffix(string(w), s)
+}
+
+// replaceSuffix swaps suffix for repl if the remaining stem has a
+// measure greater than min.  It reports if the suffix was present.
+func replaceSuffix(w *[]byte, suffix, repl string, min int) bool {
+	if !hasSuffix(*w, suffix) {
+		return false
+	}
+	base := (*w)[:len(*w)-len(suffix)]
+	if measure(base) > min {
+		*w = append(base[:len(base):len(base)], repl...)
+	}
+	return true
+}
+
+func stemStep1a(w []byte) []byte {
+	switch {
+	case hasSuffix(w, "sses"), hasSuffix(w, "ies"):
+		return w[:len(w)-2]
+	case hasSuffix(w, "ss"):
+		return w
+	case hasSuffix(w, "s"):
+		return w[:len(w)-1]
+	}
+	return w
+}
+
+func stemStep1b(w []byte) []byte {
+	if hasSuffix(w, "eed") {
+		if measure(w[:len(w)-3]) > 0 {
+			return w[:len(w)-1]
+		}
+		return w
+	}
+
+	var base []byte
+	switch {
+	case hasSuffix(w, "ed") && hasVowel(w[:len(w)-2]):
+		base = w[:len(w)-2]
+	case hasSuffix(w, "ing") && hasVowel(w[:len(w)-3]):
+		base = w[:len(w)-3]
+	default:
+		return w
+	}
+
+	switch {
+	case hasSuffix(base, "at"), hasSuffix(base, "bl"), hasSuffix(base, "iz"):
+		return append(base[:len(base):len(base)], 'e')
+	case endsDoubleConsonant(base):
+		switch base[len(base)-1] {
+		case 'l', 's', 'z':
+			return base
+		}
+		return base[:len(base)-1]
+	case measure(base) == 1 && endsCVC(base):
+		return append(base[:len(base):len(base)], 'e')
+	}
+	return base
+}
+
+func stemStep1c(w []byte) []byte {
+	if hasSuffix(w, "y") && hasVowel(w[:len(w)-1]) {
+		return append(w[:len(w)-1:len(w)-1], 'i')
+	}
+	return w
+}
+
+var step2Suffixes = [][2]string{
+	{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"},
+	{"anci", "ance"}, {"izer", "ize"}, {"abli", "able"},
+	{"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"},
+	{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"},
+	{"alism", "al"}, {"iveness", "ive"}, {"fulness", "ful"},
+	{"ousness", "ous"}, {"aliti", "al"}, {"iviti", "ive"},
+	{"biliti", "ble"},
+}
+
+func stemStep2(w []byte) []byte {
+	for _, s := range step2Suffixes {
+		if replaceSuffix(&w, s[0], s[1], 0) {
+			return w
+		}
+	}
+	return w
+}
+
+var step3Suffixes = [][2]string{
+	{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"},
+	{"ical", "ic"}, {"ful", ""}, {"ness", ""},
+}
+
+func stemStep3(w []byte) []byte {
+	for _, s := range step3Suffixes {
+		if replaceSuffix(&w, s[0], s[1], 0) {
+			return w
+		}
+	}
+	return w
+}
+
+var step4Suffixes = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement",
+	"ment", "ent", "ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+}
+
+func stemStep4(w []byte) []byte {
+	// Longest suffix wins, so "ement" is tried before "ment" and
+	// "ent".
+	best := ""
+	for _, s := range step4Suffixes {
+		if hasSuffix(w, s) && len(s) > len(best) {
+			best = s
+		}
+	}
+	if best == "" {
+		return w
+	}
+	base := w[:len(w)-len(best)]
+	if best == "ion" {
+		if len(base) == 0 || (base[len(base)-1] != 's' && base[len(base)-1] != 't') {
+			return w
+		}
+	}
+	if measure(base) > 1 {
+		return base
+	}
+	return w
+}
+
+func stemStep5(w []byte) []byte {
+	if hasSuffix(w, "e") {
+		base := w[:len(w)-1]
+		m := measure(base)
+		if m > 1 || (m == 1 && !endsCVC(base)) {
+			w = base
+		}
+	}
+	if measure(w) > 1 && endsDoubleConsonant(w) && w[len(w)-1] == 'l' {
+		w = w[:len(w)-1]
+	}
+	return w
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// storyboardTileWidth is the width of the frames of a
+	// storyboard.  Their height keeps the aspect of the video.
+	storyboardTileWidth = 128
+
+	// storyboardColumns is how many frames go in a row of the
+	// sprite sheet.
+	storyboardColumns = 10
+
+	// maxStoryboardFrames bounds the size of a sprite sheet, which
+	// covers two hours at the default interval.  Frames of longer
+	// videos are taken further apart.
+	maxStoryboardFrames = 720
+)
+
+// storyboard describes the sprite sheet of frames taken from a video
+// every Interval seconds.  Frame i is in column i%Columns of row
+// i/Columns.
+type storyboard struct {
+	Interval float64
+	Columns  int
+	Count    int
+	Width    int
+	Height   int
+}
+
+// fileDuration returns the length of a video from its container, or
+// zero if it isn't known.
+func fileDuration(path string) (float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return mediaDuration(f)
+}
+
+// storyboardPath returns where the storyboard of a video is cached,
+// without an extension.  The sheet is a .jpg and its description a
+// .json file.
+func storyboardPath(hash string) string {
+	return filepath.Join(*cacheDir, "storyboards", hash)
+}
+
+// loadStoryboard returns the storyboard of a video with the content
+// hash, or nil if it hasn't been made.
+func loadStoryboard(hash string) (*storyboard, string, error) {
+	path := storyboardPath(hash)
+	data, err := ioutil.ReadFile(path + ".json")
+	if os.IsNotExist(err) {
+		return nil, path, nil
+	} else if err != nil {
+		return nil, path, err
+	}
+	sb := &storyboard{}
+	return sb, path, json.Unmarshal(data, sb)
+}
+
+// storyboardFrameInterval returns the time between the frames of the
+// storyboard of a video lasting duration seconds, or of unknown
+// length for zero.
+func storyboardFrameInterval(duration float64) float64 {
+	return math.Max(storyboardInterval.Seconds(), duration/maxStoryboardFrames)
+}
+
+// storyboardJob takes frames from a video and saves them as a sprite
+// sheet.  When the container records the length of the video, every
+// frame up to the end has to be taken or the job fails and is tried
+// again.  Otherwise frames are taken until the thumbnail command
+// fails, which it does past the end of the video.
+func storyboardJob(ctx context.Context, j *Job) error {
+	file := j.File
+	hash, err := videoHash(file)
+	if err != nil {
+		return err
+	}
+	sb, path, err := loadStoryboard(hash)
+	if err != nil || sb != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	video := filepath.Join(*videoDir, file)
+	duration, err := fileDuration(video)
+	if err != nil {
+		log.Printf("Could not read the length of %s: %s", file, err)
+	}
+	sb = &storyboard{Interval: storyboardFrameInterval(duration), Columns: storyboardColumns}
+	frames := maxStoryboardFrames
+	if duration > 0 {
+		frames = int(math.Min(math.Ceil(duration/sb.Interval), maxStoryboardFrames))
+	}
+	frame := path + ".frame"
+	defer os.Remove(frame)
+	var tiles []*image.RGBA
+	for len(tiles) < frames {
+		err := extractFrame(ctx, video, float64(len(tiles))*sb.Interval, frame)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			if len(tiles) == 0 {
+				return err
+			}
+			// The last frame may be too close to the end to
+			// decode, any other is a failure.
+			if duration > 0 && len(tiles) < frames-1 {
+				return fmt.Errorf("frame %d of %d: %s", len(tiles)+1, frames, err)
+			}
+			break
+		}
+		img, err := decodeImage(frame)
+		if err != nil {
+			return permanent(fmt.Errorf("could not read frame: %s", err))
+		}
+		if len(tiles) == 0 {
+			b := img.Bounds()
+			sb.Width = storyboardTileWidth
+			sb.Height = storyboardTileWidth * b.Dy() / b.Dx()
+			if sb.Height == 0 {
+				return permanent(errors.New("frame has no height"))
+			}
+		}
+		tiles = append(tiles, scaleImage(img, sb.Width, sb.Height))
+	}
+	sb.Count = len(tiles)
+	j.logf("Took %d frames", sb.Count)
+	if sb.Count < sb.Columns {
+		sb.Columns = sb.Count
+	}
+
+	rows := (sb.Count + sb.Columns - 1) / sb.Columns
+	sheet := image.NewRGBA(image.Rect(0, 0, sb.Columns*sb.Width, rows*sb.Height))
+	for i, t := range tiles {
+		at := image.Pt(i%sb.Columns*sb.Width, i/sb.Columns*sb.Height)
+		draw.Draw(sheet, t.Bounds().Add(at), t, image.Point{}, draw.Src)
+	}
+	f, err := os.Create(path + ".jpg")
+	if err != nil {
+		return err
+	}
+	if err := jpeg.Encode(f, sheet, &jpeg.Options{Quality: 75}); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	// The description is written last, so a storyboard is only
+	// served once it is complete.
+	data, err := json.Marshal(sb)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path+".json", data, 0644)
+}
+
+func decodeImage(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	return img, err
+}
+
+// scaleImage resizes an image to w by h, picking the nearest pixel,
+// which is good enough for frames this small.
+func scaleImage(src image.Image, w, h int) *image.RGBA {
+	b := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		sy := b.Min.Y + y*b.Dy()/h
+		for x := 0; x < w; x++ {
+			dst.Set(x, y, src.At(b.Min.X+x*b.Dx()/w, sy))
+		}
+	}
+	return dst
+}
+
+// writeStoryboardVTT writes a WebVTT thumbnails track pointing each
+// stretch of the video at its frame in the sprite sheet.
+func writeStoryboardVTT(w *bufio.Writer, file string, sb *storyboard) error {
+	sheet := "/storyboard?file=" + url.QueryEscape(file)
+	w.WriteString("WEBVTT\n")
+	for i := 0; i < sb.Count; i++ {
+		start := float64(i) * sb.Interval
+		fmt.Fprintf(w, "\n%s --> %s\n%s#xywh=%d,%d,%d,%d\n", vttTimestamp(start), vttTimestamp(start+sb.Interval),
+			sheet, i%sb.Columns*sb.Width, i/sb.Columns*sb.Height, sb.Width, sb.Height)
+	}
+	return w.Flush()
+}
+
+// storyboardHandler serves the sprite sheet of a video, its
+// description with format=json or its thumbnails track with
+// format=vtt.
+func storyboardHandler(w http.ResponseWriter, r *http.Request) {
+	file := r.FormValue("file")
+	libraryLock.RLock()
+	e := library[file]
+	libraryLock.RUnlock()
+	if e == nil {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+
+	var sb *storyboard
+	var path string
+	if hash, ok := knownHash(file); ok {
+		var err error
+		sb, path, err = loadStoryboard(hash)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	if sb == nil {
+		if jobs.active("storyboard", file) {
+			http.Error(w, "storyboard is being made", http.StatusNotFound)
+		} else {
+			http.Error(w, "no storyboard", http.StatusNotFound)
+		}
+		return
+	}
+
+	switch r.FormValue("format") {
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(sb)
+	case "vtt":
+		w.Header().Set("Content-Type", "text/vtt; charset=utf-8")
+		if err := writeStoryboardVTT(bufio.NewWriter(w), file, sb); err != nil {
+			log.Printf("storyboardHandler: write error: %s", err)
+		}
+	default:
+		http.ServeFile(w, r, path+".jpg")
+	}
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Subtitle is a sidecar subtitle file of a video.  Sidecars share the
+// name of their video, optionally with a language code and flags
+// before the extension, so talk.mp4 may have talk.srt, talk.de.vtt
+// and talk.en.forced.srt.
+type Subtitle struct {
+	File  string
+	Lang  string `json:",omitempty"`
+	Label string
+
+	// Forced tracks only caption foreign or hard to follow speech,
+	// SDH tracks also describe sounds for the hard of hearing.
+	Forced bool `json:",omitempty"`
+	SDH    bool `json:",omitempty"`
+}
+
+// subtitleExts are the extensions of sidecar subtitle files.
+var subtitleExts = map[string]bool{
+	".srt": true,
+	".vtt": true,
+}
+
+func isSubtitle(file string) bool {
+	return subtitleExts[strings.ToLower(filepath.Ext(file))]
+}
+
+// languageNames labels the common language codes.
+var languageNames = map[string]string{
+	"ar": "Arabic",
+	"da": "Danish",
+	"de": "German",
+	"el": "Greek",
+	"en": "English",
+	"es": "Spanish",
+	"fi": "Finnish",
+	"fr": "French",
+	"he": "Hebrew",
+	"hi": "Hindi",
+	"it": "Italian",
+	"ja": "Japanese",
+	"ko": "Korean",
+	"nl": "Dutch",
+	"no": "Norwegian",
+	"pl": "Polish",
+	"pt": "Portuguese",
+	"ru": "Russian",
+	"sv": "Swedish",
+	"tr": "Turkish",
+	"uk": "Ukrainian",
+	"zh": "Chinese",
+}
+
+// languageCode matches the language codes of sidecar names, such as
+// en, deu and pt-BR.
+var languageCode = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// subtitleTrack describes a sidecar from the parts of its name
+// between the name of the video and the extension, nearest the
+// extension first.  The language is the nearest part that looks like
+// a language code, and forced, sdh and cc are taken as flags.
+func subtitleTrack(file string, parts []string) Subtitle {
+	s := Subtitle{File: file}
+	for _, p := range parts {
+		switch strings.ToLower(p) {
+		case "forced":
+			s.Forced = true
+		case "sdh", "cc":
+			s.SDH = true
+		default:
+			if s.Lang == "" && languageCode.MatchString(p) {
+				s.Lang = p
+			}
+		}
+	}
+	s.Label = subtitleLabel(s.Lang)
+	if s.Forced {
+		s.Label += " (forced)"
+	}
+	if s.SDH {
+		s.Label += " (SDH)"
+	}
+	return s
+}
+
+// subtitleLabel names a subtitle track after its language.  Regional
+// variants such as pt-BR keep the region.
+func subtitleLabel(lang string) string {
+	if lang == "" {
+		return "Subtitles"
+	}
+	base := strings.ToLower(strings.SplitN(lang, "-", 2)[0])
+	name, ok := languageNames[base]
+	if !ok {
+		return lang
+	}
+	if base != strings.ToLower(lang) {
+		name += " (" + lang[len(base)+1:] + ")"
+	}
+	return name
+}
+
+// findSubtitles pairs the subtitle files among files with the videos
+// they belong to.
+func findSubtitles(files []string) map[string][]Subtitle {
+	byBase := make(map[string][]string)
+	for _, f := range files {
+		if !isSubtitle(f) {
+			base := strings.TrimSuffix(f, filepath.Ext(f))
+			byBase[base] = append(byBase[base], f)
+		}
+	}
+
+	out := make(map[string][]Subtitle)
+	for _, f := range files {
+		if !isSubtitle(f) {
+			continue
+		}
+		name := strings.TrimSuffix(f, filepath.Ext(f))
+		var parts []string
+		for {
+			if videos := byBase[name]; len(videos) > 0 {
+				for _, v := range videos {
+					out[v] = append(out[v], subtitleTrack(f, parts))
+				}
+				break
+			}
+			i := strings.LastIndex(name, ".")
+			if i < 0 {
+				log.Printf("  Subtitle %s has no video", f)
+				break
+			}
+			parts = append(parts, name[i+1:])
+			name = name[:i]
+		}
+	}
+	return out
+}
+
+func sameSubtitles(a, b []Subtitle) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// subtitleCue is a single caption along with when it is shown.
+type subtitleCue struct {
+	Start float64
+	End   float64
+	Text  string
+}
+
+var cueMarkup = regexp.MustCompile(`<[^>]*>|\{\\[^}]*\}`)
+
+// parseCues reads the cues of an SRT or WebVTT file.  Formatting
+// tags are removed from the text.
+func parseCues(r io.Reader) ([]subtitleCue, error) {
+	var cues []subtitleCue
+	var cur *subtitleCue
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		line := strings.TrimSpace(strings.TrimPrefix(sc.Text(), "\uFEFF"))
+		switch {
+		case line == "":
+			cur = nil
+		case strings.Contains(line, "-->"):
+			parts := strings.SplitN(line, "-->", 2)
+			start, err := parseCueTime(parts[0])
+			if err != nil {
+				return nil, err
+			}
+			end, err := parseCueTime(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			cues = append(cues, subtitleCue{Start: start, End: end})
+			cur = &cues[len(cues)-1]
+		case cur != nil:
+			text := strings.TrimSpace(cueMarkup.ReplaceAllString(line, ""))
+			if cur.Text != "" {
+				text = " " + text
+			}
+			cur.Text += text
+		}
+	}
+	return cues, sc.Err()
+}
+
+// parseCueTime reads a cue timing, which SRT writes with a decimal
+// comma and WebVTT may follow with cue settings.
+func parseCueTime(s string) (float64, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("missing cue time")
+	}
+	return parseTimecode(strings.Replace(fields[0], ",", ".", 1))
+}
+
+// subtitleCues holds the cues of the sidecar files, keyed by file
+// name, so that they can be indexed and searched.
+var subtitleCues = struct {
+	sync.RWMutex
+	m map[string][]subtitleCue
+}{m: make(map[string][]subtitleCue)}
+
+// loadSubtitles reads the cues of the sidecars of an entry.
+func loadSubtitles(subs []Subtitle) {
+	for _, s := range subs {
+		f, err := os.Open(filepath.Join(*videoDir, s.File))
+		if err != nil {
+			log.Printf("Could not open subtitles %s: %s", s.File, err)
+			continue
+		}
+		cues, err := parseCues(f)
+		f.Close()
+		if err != nil {
+			log.Printf("Could not read subtitles %s: %s", s.File, err)
+			continue
+		}
+		subtitleCues.Lock()
+		subtitleCues.m[s.File] = cues
+		subtitleCues.Unlock()
+	}
+}
+
+// entryCues returns the cues of every sidecar of an entry.
+func entryCues(e *LibraryEntry) []subtitleCue {
+	subtitleCues.RLock()
+	defer subtitleCues.RUnlock()
+	var cues []subtitleCue
+	for _, s := range e.Subtitles {
+		cues = append(cues, subtitleCues.m[s.File]...)
+	}
+	return cues
+}
+
+// subtitleText returns the words spoken in a video, for the index.
+func subtitleText(e *LibraryEntry) []string {
+	var words []string
+	for _, c := range entryCues(e) {
+		words = append(words, tokenize(c.Text)...)
+	}
+	return words
+}
+
+// maxMatchingCues is how many matching cues are shown for each
+// search result.
+const maxMatchingCues = 3
+
+// cueMatch is a cue that matched a search, with the matching words
+// highlighted.
+type cueMatch struct {
+	Start float64
+	Text  []fragment
+}
+
+// matchingCues returns the first few cues of an entry containing one
+// of the stems.
+func matchingCues(e *LibraryEntry, stems map[string]bool) []cueMatch {
+	if len(stems) == 0 {
+		return nil
+	}
+	var out []cueMatch
+	for _, c := range entryCues(e) {
+		for _, w := range tokenize(c.Text) {
+			if stems[stem(w)] {
+				out = append(out, cueMatch{Start: c.Start, Text: highlight(c.Text, stems, descriptionSnippet)})
+				break
+			}
+		}
+		if len(out) == maxMatchingCues {
+			break
+		}
+	}
+	return out
+}
+
+// srtToVTT converts SRT subtitles to WebVTT, which only differs in
+// the header and the decimal separator of the cue timings.
+func srtToVTT(w io.Writer, srt []byte) error {
+	b := bufio.NewWriter(w)
+	b.WriteString("WEBVTT\n\n")
+	sc := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(srt, []byte("\uFEFF"))))
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if strings.Contains(line, "-->") {
+			line = strings.Replace(line, ",", ".", -1)
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	return b.Flush()
+}
+
+// subtitlesHandler serves a sidecar as WebVTT, converting SRT files
+// on the fly.
+func subtitlesHandler(w http.ResponseWriter, r *http.Request) {
+	file := r.FormValue("file")
+	if file != filepath.Base(file) || !isSubtitle(file) {
+		http.Error(w, "not a subtitle file", http.StatusBadRequest)
+		return
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(*videoDir, file))
+	if err != nil {
+		http.Error(w, "no such subtitle file", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/vtt; charset=utf-8")
+	if strings.ToLower(filepath.Ext(file)) == ".vtt" {
+		w.Write(data)
+		return
+	}
+	if err := srtToVTT(w, data); err != nil {
+		log.Printf("subtitlesHandler: conversion error: %s", err)
+	}
+}
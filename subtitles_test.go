@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCues(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []subtitleCue
+	}{
+		{
+			"srt",
+			"\uFEFF1\r\n00:00:01,500 --> 00:00:03,000\r\n<i>Hello</i>\r\nthere\r\n\r\n" +
+				"2\r\n00:01:00,000 --> 00:01:02,250\r\n{\\an8}Top line\r\n",
+			[]subtitleCue{{1.5, 3, "Hello there"}, {60, 62.25, "Top line"}},
+		},
+		{
+			"vtt",
+			"WEBVTT\n\nNOTE a comment\n\nintro\n00:05.000 --> 00:07.000 align:start\n<v Ann>Welcome\n",
+			[]subtitleCue{{5, 7, "Welcome"}},
+		},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		got, err := parseCues(strings.NewReader(tt.in))
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"1\n00:00:01,000 --> soon\nHi\n", "-->\n"} {
+		if _, err := parseCues(strings.NewReader(in)); err == nil {
+			t.Errorf("parseCues(%q) gave no error", in)
+		}
+	}
+}
+
+func TestSRTToVTT(t *testing.T) {
+	srt := "\uFEFF1\r\n00:00:01,500 --> 00:00:03,000\r\nOne, two\r\n\r\n2\r\n00:00:04,000 --> 00:00:05,000\r\nThree\r\n"
+	want := "WEBVTT\n\n1\n00:00:01.500 --> 00:00:03.000\nOne, two\n\n2\n00:00:04.000 --> 00:00:05.000\nThree\n"
+	var b bytes.Buffer
+	if err := srtToVTT(&b, []byte(srt)); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestFindSubtitles(t *testing.T) {
+	got := findSubtitles([]string{
+		"a.mp4", "a.srt", "a.pt-BR.vtt",
+		"b.mkv", "b.en.srt",
+		"talk.mp4", "talk.forced.en.srt", "talk.en.sdh.vtt", "talk.cc.srt",
+		"orphan.fr.srt",
+	})
+	want := map[string][]Subtitle{
+		"a.mp4": {
+			{File: "a.srt", Label: "Subtitles"},
+			{File: "a.pt-BR.vtt", Lang: "pt-BR", Label: "Portuguese (BR)"},
+		},
+		"b.mkv": {{File: "b.en.srt", Lang: "en", Label: "English"}},
+		"talk.mp4": {
+			{File: "talk.forced.en.srt", Lang: "en", Label: "English (forced)", Forced: true},
+			{File: "talk.en.sdh.vtt", Lang: "en", Label: "English (SDH)", SDH: true},
+			{File: "talk.cc.srt", Label: "Subtitles (SDH)", SDH: true},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestSubtitleLabel(t *testing.T) {
+	tests := map[string]string{
+		"":      "Subtitles",
+		"en":    "English",
+		"EN-gb": "English (gb)",
+		"xx":    "xx",
+	}
+	for in, want := range tests {
+		if got := subtitleLabel(in); got != want {
+			t.Errorf("subtitleLabel(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
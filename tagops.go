@@ -0,0 +1,335 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxTagOps is how many bulk operations are remembered for undo.
+const maxTagOps = 200
+
+// TagOp is a bulk change to the tags of the library.  The change to
+// every affected entry is recorded so that the operation can be
+// undone later.
+type TagOp struct {
+	ID      int
+	Kind    string
+	From    []string
+	To      string `json:",omitempty"`
+	Applied time.Time
+	Undone  bool
+	Changes []TagChange
+	Skipped []TagSkip `json:",omitempty"`
+}
+
+// TagChange is the effect of a TagOp on a single entry.
+type TagChange struct {
+	Filename string
+	Before   []string
+	After    []string
+}
+
+// TagSkip is an entry a TagOp leaves alone because its new tags
+// would not be accepted when saving the entry.
+type TagSkip struct {
+	Filename string
+	Reason   string
+}
+
+// tagOpResult is what the tag operation endpoints report back.
+type tagOpResult struct {
+	Op      *TagOp
+	DryRun  bool
+	Skipped []string `json:",omitempty"`
+}
+
+var (
+	tagOps    []*TagOp
+	nextTagOp = 1
+)
+
+// mapTag returns what a tag becomes under the operation, and false
+// if the tag should be removed.
+func (op *TagOp) mapTag(tag string) (string, bool) {
+	t := strings.TrimSpace(tag)
+	for _, f := range op.From {
+		if t != strings.TrimSpace(f) {
+			continue
+		}
+		switch op.Kind {
+		case "rename", "merge", "move":
+			return op.To, true
+		case "delete":
+			return "", false
+		}
+	}
+	if op.Kind == "move" {
+		// Moving a tag takes everything below it along.
+		for _, f := range op.From {
+			f = strings.TrimSpace(f) + tagSeparator
+			if strings.HasPrefix(t, f) {
+				return op.To + tagSeparator + t[len(f):], true
+			}
+		}
+	}
+	return tag, true
+}
+
+// rewriteTags applies the operation to a list of tags.  Blank and
+// duplicate tags are dropped from the result.
+func (op *TagOp) rewriteTags(tags []string) []string {
+	if op.Kind == "rules" {
+		return tagRules.apply(tags)
+	}
+
+	out := []string{}
+	seen := make(map[string]bool)
+	for _, t := range tags {
+		n, keep := op.mapTag(t)
+		n = strings.TrimSpace(n)
+		if !keep || n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		out = append(out, n)
+	}
+	return out
+}
+
+// affects reports if the operation changes any of the tags.  The
+// tidying of blank and duplicate tags alone doesn't count.
+func (op *TagOp) affects(tags []string) bool {
+	if op.Kind == "rules" {
+		return !sameTags(cleanTags(tags), tagRules.apply(tags))
+	}
+	for _, t := range tags {
+		if n, keep := op.mapTag(t); !keep || n != t {
+			return true
+		}
+	}
+	return false
+}
+
+// plan works out which entries the operation changes.  Entries
+// whose new tags would be refused are skipped.  The caller must hold
+// libraryLock.
+func (op *TagOp) plan() {
+	op.Changes = nil
+	op.Skipped = nil
+	for _, e := range library {
+		if !op.affects(e.Tags) {
+			continue
+		}
+		after, err := checkTags(e.Tags, op.rewriteTags(e.Tags))
+		if err != nil {
+			op.Skipped = append(op.Skipped, TagSkip{Filename: e.Filename, Reason: err.Error()})
+			continue
+		}
+		if !sameTags(e.Tags, after) {
+			op.Changes = append(op.Changes, TagChange{
+				Filename: e.Filename,
+				Before:   e.Tags,
+				After:    after,
+			})
+		}
+	}
+}
+
+// checkTags runs the tags an entry gets from an operation through
+// the tag rules, the namespaces and a rejecting vocabulary, as saving
+// the entry would.  Only tags the entry didn't have before are held
+// against the vocabulary, so an operation can still tidy up entries
+// tagged before it was enforced.
+func checkTags(before, after []string) ([]string, error) {
+	after, err := namespaces.check(tagRules.apply(after))
+	if err != nil {
+		return nil, err
+	}
+	if vocab.Mode != vocabReject {
+		return after, nil
+	}
+	had := make(map[string]bool)
+	for _, t := range before {
+		had[t] = true
+	}
+	var added []string
+	for _, t := range after {
+		if !had[t] {
+			added = append(added, t)
+		}
+	}
+	if _, unknown := vocab.check(added); len(unknown) > 0 {
+		return nil, vocabTagsError(unknown)
+	}
+	return after, nil
+}
+
+func sameTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// setTags replaces an entry with a copy carrying new tags.  The
+// caller must hold libraryLock for writing.
+func setTags(file string, tags []string) {
+	e := *library[file]
+	e.Tags = tags
+	e.Modified = time.Now()
+	library[file] = &e
+	index.update(&e)
+}
+
+// applyTagOp plans and applies an operation as a single change to
+// the library.  With dryRun set the plan is returned without being
+// applied or recorded.
+func applyTagOp(op *TagOp, dryRun bool) {
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
+
+	op.plan()
+	if dryRun {
+		return
+	}
+
+	for _, c := range op.Changes {
+		setTags(c.Filename, c.After)
+	}
+	op.ID = nextTagOp
+	nextTagOp++
+	op.Applied = time.Now()
+	tagOps = append(tagOps, op)
+	if len(tagOps) > maxTagOps {
+		tagOps = tagOps[len(tagOps)-maxTagOps:]
+	}
+
+	dbDirty = true
+	libraryVersion++
+	log.Printf("Applied tag %s of %q to %d entries, skipped %d", op.Kind, op.From, len(op.Changes), len(op.Skipped))
+}
+
+// undoTagOp restores the tags changed by an operation.  Entries
+// whose tags were edited again since the operation are left alone
+// and returned, as are entries that no longer exist.
+func undoTagOp(id int) (*TagOp, []string, error) {
+	libraryLock.Lock()
+	defer libraryLock.Unlock()
+
+	var op *TagOp
+	for _, o := range tagOps {
+		if o.ID == id {
+			op = o
+		}
+	}
+	if op == nil {
+		return nil, nil, fmt.Errorf("no tag operation %d", id)
+	}
+	if op.Undone {
+		return nil, nil, fmt.Errorf("tag operation %d was already undone", id)
+	}
+
+	var skipped []string
+	for _, c := range op.Changes {
+		e := library[c.Filename]
+		if e == nil || !sameTags(e.Tags, c.After) {
+			skipped = append(skipped, c.Filename)
+			continue
+		}
+		setTags(c.Filename, c.Before)
+	}
+	op.Undone = true
+
+	dbDirty = true
+	libraryVersion++
+	log.Printf("Undid tag %s %d, skipped %d entries", op.Kind, op.ID, len(skipped))
+	return op, skipped, nil
+}
+
+// tagOpHandler serves /tags/rename, /tags/merge, /tags/move and
+// /tags/delete.
+func tagOpHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "tag operations require a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	op := &TagOp{
+		Kind: strings.TrimPrefix(r.URL.Path, "/tags/"),
+		From: splitValues(r.Form["from"]),
+		To:   strings.TrimSpace(r.FormValue("to")),
+	}
+	dryRun := r.FormValue("dry_run") != ""
+
+	var err error
+	switch {
+	case len(op.From) == 0:
+		err = fmt.Errorf("no tags given to %s", op.Kind)
+	case (op.Kind == "rename" || op.Kind == "move") && len(op.From) != 1:
+		err = fmt.Errorf("%s takes a single tag, use merge for several", op.Kind)
+	case op.Kind != "delete" && op.To == "":
+		err = fmt.Errorf("no new tag name given")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	applyTagOp(op, dryRun)
+	renderTagOpResult(w, r, &tagOpResult{Op: op, DryRun: dryRun})
+}
+
+func tagUndoHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "undo requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		http.Error(w, "bad operation id", http.StatusBadRequest)
+		return
+	}
+
+	op, skipped, err := undoTagOp(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	renderTagOpResult(w, r, &tagOpResult{Op: op, Skipped: skipped})
+}
+
+func tagHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	libraryLock.RLock()
+	defer libraryLock.RUnlock()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(tagOps); err != nil {
+		log.Printf("tagHistoryHandler: encode error: %s", err)
+	}
+}
+
+// renderTagOpResult reports the outcome of an operation either as
+// JSON or on the tags page.
+func renderTagOpResult(w http.ResponseWriter, r *http.Request, res *tagOpResult) {
+	if wantJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			log.Printf("renderTagOpResult: encode error: %s", err)
+		}
+		return
+	}
+	renderTagsPage(w, res)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTagOpSkipsTagsTheVocabularyRejects(t *testing.T) {
+	defer withLibrary(
+		&LibraryEntry{Filename: "a.mp4", Tags: []string{"cat"}},
+		&LibraryEntry{Filename: "b.mp4", Tags: []string{"cat", "legacy"}},
+	)()
+	old := vocab
+	defer func() { vocab = old }()
+	vocab = newVocabulary()
+	vocab.Mode = vocabReject
+	vocab.Tags["cat"] = "cat"
+	vocab.Tags["animal"] = "animal"
+
+	op := &TagOp{Kind: "rename", From: []string{"cat"}, To: "kitten"}
+	applyTagOp(op, true)
+	if len(op.Changes) != 0 || len(op.Skipped) != 2 {
+		t.Fatalf("changes %+v, skipped %+v, want both entries skipped", op.Changes, op.Skipped)
+	}
+	if !strings.Contains(op.Skipped[0].Reason, "kitten") {
+		t.Errorf("reason %q doesn't name the rejected tag", op.Skipped[0].Reason)
+	}
+
+	// Tags the entry already had don't hold the operation up.
+	op = &TagOp{Kind: "rename", From: []string{"cat"}, To: "animal"}
+	applyTagOp(op, false)
+	if len(op.Changes) != 2 || len(op.Skipped) != 0 {
+		t.Fatalf("changes %+v, skipped %+v, want both entries changed", op.Changes, op.Skipped)
+	}
+	if got := strings.Join(library["b.mp4"].Tags, ","); got != "animal,legacy" {
+		t.Errorf("b.mp4 tags %q", got)
+	}
+}
+
+func TestTagOpAppliesTagRules(t *testing.T) {
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4", Tags: []string{"cat"}})()
+	old := tagRules
+	defer func() { tagRules = old }()
+	rules, err := parseTagRules("kitty = cat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagRules = rules
+
+	op := &TagOp{Kind: "rename", From: []string{"cat"}, To: "kitty"}
+	applyTagOp(op, true)
+	if len(op.Changes) != 0 {
+		t.Errorf("changes %+v, want the alias to map the new name back", op.Changes)
+	}
+}
+
+func TestTagOpChecksNamespaces(t *testing.T) {
+	defer withLibrary(
+		&LibraryEntry{Filename: "a.mp4", Tags: []string{"beach", "place:home"}},
+		&LibraryEntry{Filename: "b.mp4", Tags: []string{"beach"}},
+	)()
+	defer withNamespaces(t, "place single = Home, Office, Beach")()
+
+	op := &TagOp{Kind: "rename", From: []string{"beach"}, To: "Place: beach"}
+	applyTagOp(op, false)
+	if len(op.Skipped) != 1 || op.Skipped[0].Filename != "a.mp4" {
+		t.Fatalf("skipped %+v, want a.mp4 for its second place", op.Skipped)
+	}
+	if got := strings.Join(library["b.mp4"].Tags, ","); got != "place:Beach" {
+		t.Errorf("b.mp4 tags %q, want the namespaced tag written the configured way", got)
+	}
+	if got := strings.Join(library["a.mp4"].Tags, ","); got != "beach,place:home" {
+		t.Errorf("a.mp4 tags %q, want them unchanged", got)
+	}
+}
@@ -0,0 +1,395 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tagInfo describes one tag as it is used across the library.
+type tagInfo struct {
+	Name  string
+	Count int
+
+	// First and Last are the earliest and latest modification
+	// times of the entries carrying the tag.  Entries that haven't
+	// been edited since modification times were recorded fall back
+	// to their date.
+	First time.Time
+	Last  time.Time
+
+	// Similar lists other tags that are probably meant to be the
+	// same tag, such as different capitalization or plurals.
+	Similar []string `json:",omitempty"`
+}
+
+// tagSeparator divides the levels of a hierarchical tag such as
+// location/lab/bay-2.
+const tagSeparator = "/"
+
+// tagMatches reports if the tag have satisfies a search for want,
+// either by being the same tag or by being below it in the
+// hierarchy.  Case and surrounding whitespace are ignored.
+func tagMatches(have, want string) bool {
+	have = strings.ToLower(strings.TrimSpace(have))
+	want = strings.ToLower(strings.TrimSpace(want))
+	return have == want || strings.HasPrefix(have, want+tagSeparator)
+}
+
+// splitTagLevels cuts a tag after its first n levels, returning the
+// levels above the cut and the rest, and false if the tag has no
+// more than n levels.  Case folding can change the length of a
+// string, so a tag compared in lower case is cut here rather than at
+// offsets found in its lower cased form.
+func splitTagLevels(tag string, n int) (string, string, bool) {
+	parts := strings.SplitN(tag, tagSeparator, n+1)
+	if len(parts) <= n {
+		return tag, "", false
+	}
+	return strings.Join(parts[:n], tagSeparator), parts[n], true
+}
+
+// tagTreeNode is one level of the tag hierarchy.
+type tagTreeNode struct {
+	Name string
+	Path string
+
+	// Count is how many entries carry exactly this tag, Total also
+	// counts the entries carrying tags below it.
+	Count    int
+	Total    int
+	Children []*tagTreeNode
+}
+
+// tagTree arranges the tags of the library into their hierarchy.
+func tagTree(lib map[string]*LibraryEntry) []*tagTreeNode {
+	root := &tagTreeNode{}
+	nodes := map[string]*tagTreeNode{"": root}
+
+	var node func(path string) *tagTreeNode
+	node = func(path string) *tagTreeNode {
+		if n := nodes[path]; n != nil {
+			return n
+		}
+		parent, name := "", path
+		if i := strings.LastIndex(path, tagSeparator); i >= 0 {
+			parent, name = path[:i], path[i+1:]
+		}
+		n := &tagTreeNode{Name: name, Path: path}
+		p := node(parent)
+		p.Children = append(p.Children, n)
+		nodes[path] = n
+		return n
+	}
+
+	for _, e := range lib {
+		counted := make(map[*tagTreeNode]bool)
+		for _, t := range cleanTags(e.Tags) {
+			n := node(t)
+			n.Count++
+			for p := t; ; {
+				if n := nodes[p]; !counted[n] {
+					counted[n] = true
+					n.Total++
+				}
+				i := strings.LastIndex(p, tagSeparator)
+				if i < 0 {
+					break
+				}
+				p = p[:i]
+			}
+		}
+	}
+
+	var sortTree func(ns []*tagTreeNode)
+	sortTree = func(ns []*tagTreeNode) {
+		sort.Slice(ns, func(i, j int) bool { return ns[i].Name < ns[j].Name })
+		for _, n := range ns {
+			sortTree(n.Children)
+		}
+	}
+	sortTree(root.Children)
+	return root.Children
+}
+
+// tagKey reduces a tag to a form where near duplicates collide.
+func tagKey(tag string) string {
+	words := tokenize(tag)
+	for i, w := range words {
+		words[i] = stem(w)
+	}
+	return strings.Join(words, " ")
+}
+
+// tagRegistry derives the tag vocabulary from the library.  Tags
+// are reported exactly as they were entered, so that stray
+// whitespace and capitalization differences show up.  Similar tags
+// are left for findSimilarTags.
+func tagRegistry(lib map[string]*LibraryEntry) []*tagInfo {
+	tags := make(map[string]*tagInfo)
+	for _, e := range lib {
+		used := e.Modified
+		if used.IsZero() {
+			used = e.Date.Time
+		}
+		seen := make(map[string]bool)
+		for _, t := range e.Tags {
+			if strings.TrimSpace(t) == "" || seen[t] {
+				continue
+			}
+			seen[t] = true
+
+			info := tags[t]
+			if info == nil {
+				info = &tagInfo{Name: t}
+				tags[t] = info
+			}
+			info.Count++
+			if !used.IsZero() {
+				if info.First.IsZero() || used.Before(info.First) {
+					info.First = used
+				}
+				if used.After(info.Last) {
+					info.Last = used
+				}
+			}
+		}
+	}
+
+	out := make([]*tagInfo, 0, len(tags))
+	for _, info := range tags {
+		out = append(out, info)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// findSimilarTags fills in the near duplicates of each tag: those
+// with the same key and those whose keys are an edit apart.  Every
+// pair of keys is compared, so this is kept off the completion path.
+func findSimilarTags(tags []*tagInfo) {
+	keys := make(map[string][]string)
+	byName := make(map[string]*tagInfo)
+	for _, info := range tags {
+		k := tagKey(info.Name)
+		keys[k] = append(keys[k], info.Name)
+		byName[info.Name] = info
+	}
+	keyList := make([]string, 0, len(keys))
+	for k := range keys {
+		keyList = append(keyList, k)
+	}
+	for _, k := range keyList {
+		var similar []string
+		for _, other := range keyList {
+			if other == k {
+				continue
+			}
+			if maxEdits(k) > 0 && editDistance(k, other, 1) <= 1 {
+				similar = append(similar, keys[other]...)
+			}
+		}
+		for _, t := range keys[k] {
+			info := byName[t]
+			for _, s := range keys[k] {
+				if s != t {
+					info.Similar = append(info.Similar, s)
+				}
+			}
+			info.Similar = append(info.Similar, similar...)
+			sort.Strings(info.Similar)
+		}
+	}
+}
+
+var (
+	registryLock    sync.Mutex
+	registryCache   []*tagInfo
+	registryVersion = -1
+	registrySimilar bool
+)
+
+// currentTagRegistry returns the tag registry of the library, which
+// is only worked out again after the library changes.  The caller
+// must hold libraryLock.
+func currentTagRegistry() []*tagInfo {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	return refreshTagRegistry()
+}
+
+// similarTagRegistry is currentTagRegistry with the similar tags
+// filled in, which is only done when the registry is shown.  The
+// caller must hold libraryLock.
+func similarTagRegistry() []*tagInfo {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	tags := refreshTagRegistry()
+	if !registrySimilar {
+		findSimilarTags(tags)
+		registrySimilar = true
+	}
+	return tags
+}
+
+// refreshTagRegistry works the registry out again if the library
+// changed.  The caller must hold registryLock.
+func refreshTagRegistry() []*tagInfo {
+	if registryVersion != libraryVersion {
+		registryCache = tagRegistry(library)
+		registryVersion = libraryVersion
+		registrySimilar = false
+	}
+	return registryCache
+}
+
+// tagCompletion is a suggested tag for a partially typed one.
+type tagCompletion struct {
+	Tag   string
+	Count int
+	score float64
+}
+
+// recencyHalfLife is how quickly a tag stops counting as recently
+// used when ranking completions.
+const recencyHalfLife = 30 * 24 * time.Hour
+
+// completeTag returns up to limit tags matching a prefix, ranked by
+// how often and how recently they are used.  The prefix may match
+// the start of the tag or of any level in its hierarchy, though
+// matches at the start rank higher.  When a vocabulary is in force
+// its unused tags are offered too, as are the allowed values of the
+// namespaces.  The caller must hold libraryLock.
+func completeTag(prefix string, limit int) []tagCompletion {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	now := time.Now()
+
+	out := []tagCompletion{}
+	seen := make(map[string]bool)
+	consider := func(tag string, count int, last time.Time) {
+		lt := strings.ToLower(tag)
+		if seen[lt] {
+			return
+		}
+		weight := 0.0
+		switch {
+		case strings.HasPrefix(lt, prefix):
+			weight = 2
+		case strings.Contains(lt, tagSeparator+prefix):
+			weight = 1
+		default:
+			return
+		}
+		seen[lt] = true
+
+		recency := 0.0
+		if !last.IsZero() {
+			recency = math.Exp2(-float64(now.Sub(last)) / float64(recencyHalfLife))
+		}
+		out = append(out, tagCompletion{
+			Tag:   tag,
+			Count: count,
+			score: weight * (float64(count) + 1) * (1 + recency),
+		})
+	}
+
+	for _, info := range currentTagRegistry() {
+		if vocab.accepts(info.Name) || namespaces.controls(info.Name) {
+			consider(strings.TrimSpace(info.Name), info.Count, info.Last)
+		}
+	}
+	if vocab.Mode != vocabOff {
+		for _, t := range vocab.Tags {
+			consider(t, 0, time.Time{})
+		}
+	}
+	for _, ns := range namespaces.list() {
+		for _, v := range ns.Values {
+			consider(ns.Name+namespaceSeparator+v, 0, time.Time{})
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].score != out[j].score {
+			return out[i].score > out[j].score
+		}
+		return out[i].Tag < out[j].Tag
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func tagCompleteHandler(w http.ResponseWriter, r *http.Request) {
+	limit := 10
+	if l, err := strconv.Atoi(r.FormValue("limit")); err == nil && l > 0 && l <= 100 {
+		limit = l
+	}
+
+	libraryLock.RLock()
+	tags := completeTag(r.FormValue("prefix"), limit)
+	libraryLock.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(tags); err != nil {
+		log.Printf("tagCompleteHandler: encode error: %s", err)
+	}
+}
+
+func tagsHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if wantJSON(r) {
+		libraryLock.RLock()
+		tags := similarTagRegistry()
+		libraryLock.RUnlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(tags); err != nil {
+			log.Printf("tagsHandler: encode error: %s", err)
+		}
+		return
+	}
+	renderTagsPage(w, nil)
+}
+
+// renderTagsPage shows the tag registry and the history of bulk
+// operations, along with the result of an operation if there was
+// one.
+func renderTagsPage(w http.ResponseWriter, res *tagOpResult) {
+	s := struct {
+		Tags    []*tagInfo
+		Tree    []*tagTreeNode
+		History []*TagOp
+		Result  *tagOpResult
+	}{
+		Result: res,
+	}
+
+	libraryLock.RLock()
+	s.Tags = similarTagRegistry()
+	s.Tree = tagTree(library)
+	for i := len(tagOps) - 1; i >= 0; i-- {
+		s.History = append(s.History, tagOps[i])
+	}
+	libraryLock.RUnlock()
+
+	err := tagsTmpl.ExecuteTemplate(w, "layout", s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
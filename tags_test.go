@@ -0,0 +1,48 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSimilarTagsOnlyForTheRegistryPage(t *testing.T) {
+	defer withLibrary(
+		&LibraryEntry{Filename: "a.mp4", Tags: []string{"meeting"}},
+		&LibraryEntry{Filename: "b.mp4", Tags: []string{"Meetings", "lab"}},
+	)()
+	libraryVersion++
+
+	for _, info := range currentTagRegistry() {
+		if info.Similar != nil {
+			t.Errorf("%s has similar tags %q before the registry was shown", info.Name, info.Similar)
+		}
+	}
+	similar := make(map[string][]string)
+	for _, info := range similarTagRegistry() {
+		similar[info.Name] = info.Similar
+	}
+	want := map[string][]string{"meeting": {"Meetings"}, "Meetings": {"meeting"}, "lab": nil}
+	if !reflect.DeepEqual(similar, want) {
+		t.Errorf("similar tags %q, want %q", similar, want)
+	}
+}
+
+func TestSplitTagLevels(t *testing.T) {
+	tests := []struct {
+		tag          string
+		n            int
+		above, below string
+		ok           bool
+	}{
+		{"location/lab/bay-2", 1, "location", "lab/bay-2", true},
+		{"location/lab/bay-2", 2, "location/lab", "bay-2", true},
+		{"location/lab", 2, "location/lab", "", false},
+		{"\u0130stanbul/Office", 1, "\u0130stanbul", "Office", true},
+	}
+	for _, tt := range tests {
+		above, below, ok := splitTagLevels(tt.tag, tt.n)
+		if above != tt.above || below != tt.below || ok != tt.ok {
+			t.Errorf("splitTagLevels(%q, %d) = %q, %q, %v", tt.tag, tt.n, above, below, ok)
+		}
+	}
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/jpeg"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// thumbTimeout bounds how long the thumbnail command may run.
+const thumbTimeout = time.Minute
+
+// shortVideoThumb is how far into a video shorter than the default
+// thumbnail time allows its default frame is taken, as a fraction of
+// its length.
+const shortVideoThumb = 0.25
+
+// maxCoverArt is the largest embedded cover image that is read.
+const maxCoverArt = 16 << 20
+
+// stillThumbWidth is the width thumbnails of still images are scaled
+// down to.
+const stillThumbWidth = 320
+
+var errNoThumbnail = errors.New("no thumbnail could be made")
+
+// thumbPath returns where the thumbnail of a video taken at a time is
+// cached.
+func thumbPath(hash string, at float64) string {
+	return filepath.Join(*cacheDir, "thumbs", fmt.Sprintf("%s-%d", hash, int64(at*1000)))
+}
+
+// thumbLocks keeps two jobs from making the same thumbnail at
+// once.
+var thumbLocks = struct {
+	sync.Mutex
+	m map[string]*sync.Mutex
+}{m: make(map[string]*sync.Mutex)}
+
+func thumbLock(key string) *sync.Mutex {
+	thumbLocks.Lock()
+	defer thumbLocks.Unlock()
+	l := thumbLocks.m[key]
+	if l == nil {
+		l = &sync.Mutex{}
+		thumbLocks.m[key] = l
+	}
+	return l
+}
+
+// posterTime returns the time of the thumbnail frame, given the
+// poster time of an entry.  The default frame of a short video is
+// taken earlier, see defaultFrameTime, but cached under this time.
+func posterTime(poster float64) float64 {
+	if poster == 0 {
+		return thumbTime.Seconds()
+	}
+	return poster
+}
+
+// defaultFrameTime returns the time of the default thumbnail frame of
+// a video lasting duration seconds, or zero if that isn't known.  A
+// frame past the end can't be taken, so short videos have it a
+// fraction of the way in.
+func defaultFrameTime(duration float64) float64 {
+	at := thumbTime.Seconds()
+	if duration > 0 && at > duration*shortVideoThumb {
+		at = duration * shortVideoThumb
+	}
+	return at
+}
+
+// thumbnail returns the path of the cached thumbnail of a video,
+// making it first if needed.  A poster time of zero means the
+// default frame.  Still images are scaled down and audio only has
+// its cover art.
+func thumbnail(ctx context.Context, file string, poster float64) (string, error) {
+	hash, err := videoHash(file)
+	if err != nil {
+		return "", err
+	}
+	kind := kindVideo
+	libraryLock.RLock()
+	if e := library[file]; e != nil {
+		kind = entryKind(e)
+	}
+	libraryLock.RUnlock()
+	at := posterTime(poster)
+	path := thumbPath(hash, at)
+
+	l := thumbLock(path)
+	l.Lock()
+	defer l.Unlock()
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	video := filepath.Join(*videoDir, file)
+	switch kind {
+	case kindImage:
+		// The thumbnail command is only needed for the formats
+		// Go can't decode.
+		if err = scaleStill(video, path); err != nil {
+			err = extractFrame(ctx, video, 0, path)
+		}
+	case kindAudio:
+		err = permanent(errors.New("audio has no frames"))
+	default:
+		if poster == 0 {
+			duration, err := fileDuration(video)
+			if err != nil {
+				log.Printf("Could not read the length of %s: %s", file, err)
+			}
+			at = defaultFrameTime(duration)
+		}
+		err = extractFrame(ctx, video, at, path)
+	}
+	if err == nil {
+		return path, nil
+	}
+	if poster != 0 {
+		// A chosen frame can only come from the decoder.
+		return "", err
+	}
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+	log.Printf("Falling back to cover art for %s: %s", file, err)
+
+	art, artErr := coverArt(video)
+	if artErr != nil {
+		log.Printf("Could not read the cover art of %s: %s", file, artErr)
+	}
+	if art == nil {
+		if _, ok := err.(permanentError); ok {
+			return "", permanent(errNoThumbnail)
+		}
+		// The decoder may work when tried again.
+		return "", err
+	}
+	if err := ioutil.WriteFile(path, art, 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// extractFrame runs the thumbnail command to write the frame of a
+// video at a time to out.  The command may use {input}, {output} and
+// {time}.
+func extractFrame(ctx context.Context, video string, at float64, out string) error {
+	ctx, cancel := context.WithTimeout(ctx, thumbTimeout)
+	defer cancel()
+	err := runCommand(ctx, "thumbnail", *thumbCmd, map[string]string{
+		"input":  video,
+		"output": out,
+		"time":   strconv.FormatFloat(at, 'f', 3, 64),
+	})
+	if err != nil {
+		os.Remove(out)
+		return err
+	}
+	if st, err := os.Stat(out); err != nil || st.Size() == 0 {
+		os.Remove(out)
+		return errors.New("thumbnail command wrote no image")
+	}
+	return nil
+}
+
+// scaleStill writes a JPEG thumbnail of a still image to out.
+func scaleStill(still, out string) error {
+	img, err := decodeImage(still)
+	if err != nil {
+		return err
+	}
+	b := img.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 {
+		return errors.New("empty image")
+	}
+	w, h := b.Dx(), b.Dy()
+	if w > stillThumbWidth {
+		w, h = stillThumbWidth, h*stillThumbWidth/w
+		if h == 0 {
+			h = 1
+		}
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	if err := jpeg.Encode(f, scaleImage(img, w, h), nil); err != nil {
+		f.Close()
+		os.Remove(out)
+		return err
+	}
+	return f.Close()
+}
+
+// coverArt returns the cover image embedded in a video, or nil if
+// there is none.
+func coverArt(video string) ([]byte, error) {
+	f, err := os.Open(video)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, _ := io.ReadFull(f, head)
+	switch sniffMIME(head[:n]) {
+	case "video/mp4", "video/quicktime", "video/3gpp":
+		return mp4CoverArt(f)
+	case "video/webm", "video/x-matroska":
+		return mkvCoverArt(f)
+	}
+	return nil, nil
+}
+
+// mp4CoverArt reads the covr item of the iTunes style metadata in
+// moov/udta/meta/ilst.
+func mp4CoverArt(r io.ReadSeeker) ([]byte, error) {
+	meta, err := mp4Find(r, "moov", "udta", "meta")
+	if err != nil || meta == nil {
+		return nil, err
+	}
+
+	// The meta box of MP4 files starts with a version and flags,
+	// the QuickTime one doesn't.
+	var ilst *mp4Box
+	for _, skip := range []int64{4, 0} {
+		boxes, err := mp4Boxes(r, meta.Start+skip, meta.Start+meta.Size)
+		if err != nil {
+			continue
+		}
+		for i := range boxes {
+			if boxes[i].Type == "ilst" {
+				ilst = &boxes[i]
+			}
+		}
+		if ilst != nil {
+			break
+		}
+	}
+	if ilst == nil {
+		return nil, nil
+	}
+
+	items, err := mp4Boxes(r, ilst.Start, ilst.Start+ilst.Size)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.Type != "covr" {
+			continue
+		}
+		data, err := mp4Boxes(r, item.Start, item.Start+item.Size)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range data {
+			if d.Type != "data" || d.Size <= 8 {
+				continue
+			}
+			// The image follows a type and a locale word.
+			img := mp4Box{Type: d.Type, Start: d.Start + 8, Size: d.Size - 8}
+			return mp4Read(r, &img, maxCoverArt)
+		}
+	}
+	return nil, nil
+}
+
+// mkvCoverArt reads the attached image of a Matroska file, preferring
+// one named as a cover.
+func mkvCoverArt(r io.ReadSeeker) ([]byte, error) {
+	var data []byte
+	err := mkvTopLevel(r, func(el ebmlElement) (bool, error) {
+		if el.ID != mkvAttachments {
+			return true, nil
+		}
+		var err error
+		data, err = mkvRead(r, el, maxCoverArt)
+		return false, err
+	})
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	files, err := ebmlChildren(data)
+	if err != nil {
+		return nil, err
+	}
+	var best []byte
+	for _, af := range files {
+		if af.ID != mkvAttachedFile {
+			continue
+		}
+		payload := data[af.Start : af.Start+af.Size]
+		fields, err := ebmlChildren(payload)
+		if err != nil {
+			return nil, err
+		}
+		var name, mimeType string
+		var content []byte
+		for _, f := range fields {
+			v := payload[f.Start : f.Start+f.Size]
+			switch f.ID {
+			case mkvFileName:
+				name = strings.ToLower(string(v))
+			case mkvFileMimeType:
+				mimeType = string(v)
+			case mkvFileData:
+				content = v
+			}
+		}
+		if !strings.HasPrefix(mimeType, "image/") {
+			continue
+		}
+		if strings.HasPrefix(name, "cover") {
+			return content, nil
+		}
+		if best == nil {
+			best = content
+		}
+	}
+	return best, nil
+}
+
+// thumbnailJob makes the thumbnail of a video.  With the t argument
+// it makes the frame at that time, and then chooses it as the
+// thumbnail of the entry if the choose argument is set.
+func thumbnailJob(ctx context.Context, j *Job) error {
+	poster := 0.0
+	if t, ok := j.Args["t"]; ok {
+		var err error
+		if poster, err = strconv.ParseFloat(t, 64); err != nil {
+			return permanent(err)
+		}
+	} else {
+		libraryLock.RLock()
+		if e := library[j.File]; e != nil {
+			poster = e.Poster
+		}
+		libraryLock.RUnlock()
+	}
+
+	path, err := thumbnail(ctx, j.File, poster)
+	if err != nil {
+		return err
+	}
+	j.logf("Made %s", filepath.Base(path))
+
+	if j.Args["choose"] != "" {
+		libraryLock.Lock()
+		if old := library[j.File]; old != nil {
+			e := *old
+			e.Poster = poster
+			library[j.File] = &e
+			dbDirty = true
+			libraryVersion++
+		}
+		libraryLock.Unlock()
+		j.logf("Chose the frame at %s as the thumbnail", formatTimecode(poster))
+	}
+	return nil
+}
+
+// thumbnailHandler serves the thumbnail of a video, queueing a job to
+// make it if it isn't cached yet.  Posting a time with t queues a job
+// choosing the frame at that time as the thumbnail, and posting t=0
+// goes back to the default.
+func thumbnailHandler(w http.ResponseWriter, r *http.Request) {
+	file := r.FormValue("file")
+
+	libraryLock.RLock()
+	e := library[file]
+	libraryLock.RUnlock()
+	if e == nil {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		t, err := strconv.ParseFloat(r.FormValue("t"), 64)
+		if err != nil || t < 0 {
+			http.Error(w, "bad time", http.StatusBadRequest)
+			return
+		}
+		j := jobs.add("thumbnail", file, map[string]string{
+			"t":      strconv.FormatFloat(t, 'f', 3, 64),
+			"choose": "1",
+		})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		json.NewEncoder(w).Encode(j)
+		return
+	}
+
+	hash, ok := knownHash(file)
+	if ok {
+		path := thumbPath(hash, posterTime(e.Poster))
+		if f, err := os.Open(path); err == nil {
+			defer f.Close()
+			if st, err := f.Stat(); err == nil {
+				w.Header().Set("Cache-Control", "no-cache")
+				http.ServeContent(w, r, "", st.ModTime(), f)
+				return
+			}
+		}
+	}
+
+	j := jobs.add("thumbnail", file, nil)
+	if j.Status == jobFailed {
+		http.Error(w, "no thumbnail: "+j.Error, http.StatusNotFound)
+	} else {
+		http.Error(w, "thumbnail is being made", http.StatusNotFound)
+	}
+}
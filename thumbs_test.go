@@ -0,0 +1,25 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultFrameTime(t *testing.T) {
+	old := *thumbTime
+	defer func() { *thumbTime = old }()
+	*thumbTime = 5 * time.Second
+
+	tests := map[float64]float64{
+		0:    5, // unknown length
+		3600: 5,
+		20:   5,
+		8:    2,
+		0.4:  0.1,
+	}
+	for duration, want := range tests {
+		if got := defaultFrameTime(duration); got != want {
+			t.Errorf("defaultFrameTime(%v) = %v, want %v", duration, got, want)
+		}
+	}
+}
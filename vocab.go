@@ -0,0 +1,246 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Vocabulary modes.  In reject mode an update carrying an unknown
+// tag fails, in propose mode the unknown tags are held back from the
+// entry and queued for an administrator to approve.
+const (
+	vocabOff     = "off"
+	vocabReject  = "reject"
+	vocabPropose = "propose"
+)
+
+// Vocabulary is the administrator managed list of accepted tags.
+type Vocabulary struct {
+	Mode string
+
+	// Tags maps the lower cased form of each accepted tag to how
+	// it is written.
+	Tags map[string]string
+
+	// Proposed holds the unknown tags that were held back, keyed
+	// by the lower cased tag.
+	Proposed map[string]*ProposedTag
+}
+
+// ProposedTag is an unknown tag waiting for approval, along with the
+// entries it was meant for.
+type ProposedTag struct {
+	Tag      string
+	Files    []string
+	Proposed time.Time
+}
+
+var vocab = newVocabulary()
+
+func newVocabulary() *Vocabulary {
+	return &Vocabulary{
+		Mode:     vocabOff,
+		Tags:     make(map[string]string),
+		Proposed: make(map[string]*ProposedTag),
+	}
+}
+
+// accepts reports if the tag is in the vocabulary.  With the
+// vocabulary off every tag is accepted.
+func (v *Vocabulary) accepts(tag string) bool {
+	if v.Mode == vocabOff {
+		return true
+	}
+	_, ok := v.Tags[strings.ToLower(strings.TrimSpace(tag))]
+	return ok
+}
+
+// sortedTags returns the accepted tags in order.
+func (v *Vocabulary) sortedTags() []string {
+	tags := make([]string, 0, len(v.Tags))
+	for _, t := range v.Tags {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// check splits tags into those the vocabulary accepts and those it
+// doesn't.  Tags in a namespace with its own list of allowed values
+// are left to the namespace.
+func (v *Vocabulary) check(tags []string) ([]string, []string) {
+	var known, unknown []string
+	for _, t := range tags {
+		if v.accepts(t) || namespaces.controls(t) {
+			known = append(known, t)
+		} else {
+			unknown = append(unknown, t)
+		}
+	}
+	return known, unknown
+}
+
+// propose queues unknown tags for approval.  The caller must hold
+// libraryLock for writing.
+func (v *Vocabulary) propose(file string, tags []string) {
+	for _, t := range tags {
+		key := strings.ToLower(t)
+		p := v.Proposed[key]
+		if p == nil {
+			p = &ProposedTag{Tag: t, Proposed: time.Now()}
+			v.Proposed[key] = p
+		}
+		found := false
+		for _, f := range p.Files {
+			found = found || f == file
+		}
+		if !found {
+			p.Files = append(p.Files, file)
+		}
+	}
+}
+
+// vocabTagsError is returned by updates rejected for unknown tags.
+type vocabTagsError []string
+
+func (e vocabTagsError) Error() string {
+	return fmt.Sprintf("tags not in the vocabulary: %s", strings.Join(e, ", "))
+}
+
+// enforce applies the vocabulary to an entry about to be saved.  It
+// returns the tags that were queued for approval, or an error if the
+// update must be rejected.  The caller must hold libraryLock for
+// writing.
+func (v *Vocabulary) enforce(e *LibraryEntry) ([]string, error) {
+	known, unknown := v.check(e.Tags)
+	if len(unknown) == 0 {
+		return nil, nil
+	}
+
+	switch v.Mode {
+	case vocabReject:
+		return nil, vocabTagsError(unknown)
+	case vocabPropose:
+		e.Tags = known
+		if e.Tags == nil {
+			e.Tags = []string{}
+		}
+		v.propose(e.Filename, unknown)
+		log.Printf("Queued proposed tags %q for %s", unknown, e.Filename)
+		return unknown, nil
+	}
+	return nil, nil
+}
+
+func vocabHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		mode := r.FormValue("mode")
+		switch mode {
+		case vocabOff, vocabReject, vocabPropose:
+		default:
+			http.Error(w, fmt.Sprintf("unknown vocabulary mode %q", mode), http.StatusBadRequest)
+			return
+		}
+
+		tags := make(map[string]string)
+		for _, line := range strings.Split(r.FormValue("tags"), "\n") {
+			if t := strings.TrimSpace(line); t != "" {
+				tags[strings.ToLower(t)] = t
+			}
+		}
+
+		libraryLock.Lock()
+		vocab.Mode = mode
+		vocab.Tags = tags
+		dbDirty = true
+		libraryLock.Unlock()
+		log.Printf("Vocabulary is now %s with %d tags", mode, len(tags))
+	}
+
+	renderVocabPage(w, r)
+}
+
+// vocabDecideHandler approves or rejects a proposed tag.  Approving
+// adds the tag to the vocabulary and to the entries it was proposed
+// for.
+func vocabDecideHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "deciding on a tag requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	key := strings.ToLower(strings.TrimSpace(r.FormValue("tag")))
+	approve := strings.HasSuffix(r.URL.Path, "/approve")
+
+	libraryLock.Lock()
+	p := vocab.Proposed[key]
+	if p == nil {
+		libraryLock.Unlock()
+		http.Error(w, "no such proposed tag", http.StatusNotFound)
+		return
+	}
+	delete(vocab.Proposed, key)
+
+	if approve {
+		vocab.Tags[key] = p.Tag
+		for _, f := range p.Files {
+			e := library[f]
+			if e == nil || hasExactTag(e, p.Tag) {
+				continue
+			}
+			setTags(f, append(append([]string{}, e.Tags...), p.Tag))
+		}
+		libraryVersion++
+		log.Printf("Approved tag %q for %d entries", p.Tag, len(p.Files))
+	} else {
+		log.Printf("Rejected proposed tag %q", p.Tag)
+	}
+	dbDirty = true
+	libraryLock.Unlock()
+
+	renderVocabPage(w, r)
+}
+
+func renderVocabPage(w http.ResponseWriter, r *http.Request) {
+	s := struct {
+		Mode     string
+		Tags     []string
+		Proposed []*ProposedTag
+	}{}
+
+	libraryLock.RLock()
+	s.Mode = vocab.Mode
+	s.Tags = vocab.sortedTags()
+	for _, p := range vocab.Proposed {
+		s.Proposed = append(s.Proposed, p)
+	}
+	libraryLock.RUnlock()
+	sort.Slice(s.Proposed, func(i, j int) bool { return s.Proposed[i].Tag < s.Proposed[j].Tag })
+
+	if wantJSON(r) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(s); err != nil {
+			log.Printf("renderVocabPage: encode error: %s", err)
+		}
+		return
+	}
+
+	err := vocbTmpl.ExecuteTemplate(w, "layout", s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
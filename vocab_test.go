@@ -0,0 +1,37 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestVocabApproveAddsExactTag(t *testing.T) {
+	defer withLibrary(
+		&LibraryEntry{Filename: "a.mp4", Tags: []string{"people/alice"}},
+		&LibraryEntry{Filename: "b.mp4", Tags: []string{"People"}},
+	)()
+	old := vocab
+	defer func() { vocab = old }()
+	vocab = newVocabulary()
+	vocab.Proposed["people"] = &ProposedTag{Tag: "people", Files: []string{"a.mp4", "b.mp4"}}
+
+	form := url.Values{"tag": {"people"}}
+	r := httptest.NewRequest("POST", "/vocab/approve", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set("Accept", "application/json")
+	w := httptest.NewRecorder()
+	vocabDecideHandler(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body)
+	}
+
+	if got := strings.Join(library["a.mp4"].Tags, ","); got != "people/alice,people" {
+		t.Errorf("a.mp4 tags %q, want the approved tag added", got)
+	}
+	if got := strings.Join(library["b.mp4"].Tags, ","); got != "People" {
+		t.Errorf("b.mp4 tags %q, want them unchanged", got)
+	}
+}
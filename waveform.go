@@ -0,0 +1,327 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// waveformSampleRate is the rate the decoder is asked to
+	// resample the audio to, which is plenty for a waveform.
+	waveformSampleRate = 8000
+
+	// waveformRate is how many peaks are kept for each second.
+	waveformRate = 10
+
+	// minSegmentLength is the shortest stretch of sound proposed as
+	// a segment, so that coughs and clicks in a pause are skipped.
+	minSegmentLength = 0.5
+
+	// silenceFloor is the level given to digital silence, in dBFS.
+	silenceFloor = -100
+)
+
+// waveform is the outline of the audio of a video.  Each of the Rate
+// buckets in a second has the peak amplitude, from 0 to 100, and the
+// RMS level in dBFS of its samples.  The levels are kept so that
+// silence can be looked for with different thresholds.
+type waveform struct {
+	Rate   int
+	Peaks  []int
+	Levels []int
+}
+
+// Segment is a stretch of sound between two silences.
+type Segment struct {
+	Start float64
+	End   float64
+}
+
+// peakMeter collects the peaks and levels of 16 bit little endian
+// mono samples written to it.
+type peakMeter struct {
+	perBucket int
+	n         int
+	peak      int
+	sumSq     float64
+	odd       []byte
+	w         waveform
+}
+
+func newPeakMeter() *peakMeter {
+	return &peakMeter{perBucket: waveformSampleRate / waveformRate, w: waveform{Rate: waveformRate}}
+}
+
+func (m *peakMeter) Write(p []byte) (int, error) {
+	n := len(p)
+	if len(m.odd) > 0 {
+		p = append(m.odd, p...)
+		m.odd = nil
+	}
+	for ; len(p) >= 2; p = p[2:] {
+		s := int(int16(binary.LittleEndian.Uint16(p)))
+		if s < 0 {
+			s = -s
+		}
+		if s > m.peak {
+			m.peak = s
+		}
+		m.sumSq += float64(s) * float64(s)
+		m.n++
+		if m.n == m.perBucket {
+			m.flush()
+		}
+	}
+	if len(p) == 1 {
+		m.odd = []byte{p[0]}
+	}
+	return n, nil
+}
+
+// flush closes the current bucket.
+func (m *peakMeter) flush() {
+	if m.n == 0 {
+		return
+	}
+	level := silenceFloor
+	if m.sumSq > 0 {
+		rms := math.Sqrt(m.sumSq/float64(m.n)) / 32768
+		level = int(math.Max(silenceFloor, math.Round(20*math.Log10(rms))))
+	}
+	m.w.Peaks = append(m.w.Peaks, m.peak*100/32768)
+	m.w.Levels = append(m.w.Levels, level)
+	m.n, m.peak, m.sumSq = 0, 0, 0
+}
+
+// segments returns the stretches of sound separated by silences, where
+// the level stays under threshold dBFS for at least minSilence
+// seconds.
+func (w *waveform) segments(threshold int, minSilence float64) []Segment {
+	out := []Segment{}
+	minRun := int(math.Ceil(minSilence * float64(w.Rate)))
+	if minRun < 1 {
+		minRun = 1
+	}
+	add := func(from, to int) {
+		if from < to && float64(to-from)/float64(w.Rate) >= minSegmentLength {
+			out = append(out, Segment{Start: float64(from) / float64(w.Rate), End: float64(to) / float64(w.Rate)})
+		}
+	}
+
+	start, quiet := 0, 0
+	for i, l := range w.Levels {
+		if l < threshold {
+			quiet++
+			continue
+		}
+		if quiet >= minRun {
+			add(start, i-quiet)
+			start = i
+		}
+		quiet = 0
+	}
+	end := len(w.Levels)
+	if quiet >= minRun {
+		end -= quiet
+	}
+	add(start, end)
+	return out
+}
+
+func waveformsEnabled() bool {
+	return *pcmCmd != ""
+}
+
+// waveformPath returns where the waveform of a video with a content
+// hash is cached.
+func waveformPath(hash string) string {
+	return filepath.Join(*cacheDir, "waveforms", hash+".json")
+}
+
+// waveformJob works out the waveform of a video.  The PCM command
+// gets {input} and {rate}, and prints the audio as signed 16 bit
+// little endian mono samples at that rate.
+func waveformJob(ctx context.Context, j *Job) error {
+	hash, err := videoHash(j.File)
+	if err != nil {
+		return err
+	}
+	path := waveformPath(hash)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	m := newPeakMeter()
+	err = streamCommand(ctx, "PCM", *pcmCmd, map[string]string{
+		"input": filepath.Join(*videoDir, j.File),
+		"rate":  strconv.Itoa(waveformSampleRate),
+	}, m)
+	if err != nil {
+		return err
+	}
+	m.flush()
+	if len(m.w.Peaks) == 0 {
+		return permanent(errors.New("the video has no audio"))
+	}
+
+	data, err := json.Marshal(&m.w)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		return err
+	}
+	j.logf("Measured %d seconds of audio", len(m.w.Peaks)/m.w.Rate)
+	return nil
+}
+
+// loadWaveform returns the waveform of an entry, or nil along with
+// the job making it.
+func loadWaveform(file string) (*waveform, *Job, error) {
+	if hash, ok := knownHash(file); ok {
+		data, err := ioutil.ReadFile(waveformPath(hash))
+		if err == nil {
+			w := &waveform{}
+			return w, nil, json.Unmarshal(data, w)
+		} else if !os.IsNotExist(err) {
+			return nil, nil, err
+		}
+	}
+	j := jobs.add("waveform", file, nil)
+	return nil, &j, nil
+}
+
+// silenceOptions reads the threshold in dBFS and the shortest
+// silence in seconds from the db and min form values, defaulting to
+// the flags.
+func silenceOptions(r *http.Request) (int, float64, error) {
+	threshold, minSilence := *silenceDB, silenceMin.Seconds()
+	if v := r.FormValue("db"); v != "" {
+		db, err := strconv.Atoi(v)
+		if err != nil || db > 0 || db < silenceFloor {
+			return 0, 0, errors.New("bad silence threshold")
+		}
+		threshold = db
+	}
+	if v := r.FormValue("min"); v != "" {
+		sec, err := strconv.ParseFloat(v, 64)
+		if err != nil || sec <= 0 {
+			return 0, 0, errors.New("bad shortest silence")
+		}
+		minSilence = sec
+	}
+	return threshold, minSilence, nil
+}
+
+// waveformHandler serves the peaks of a video along with the segments
+// proposed by silence detection, queueing a job to work them out if
+// needed.
+func waveformHandler(w http.ResponseWriter, r *http.Request) {
+	file := r.FormValue("file")
+	libraryLock.RLock()
+	e := library[file]
+	libraryLock.RUnlock()
+	if e == nil || !timedMedia(e) {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+	if !waveformsEnabled() {
+		http.Error(w, "waveforms are not configured", http.StatusNotFound)
+		return
+	}
+	threshold, minSilence, err := silenceOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	wf, job, err := loadWaveform(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	var v interface{}
+	if wf == nil {
+		w.WriteHeader(http.StatusAccepted)
+		v = struct{ Job *Job }{job}
+	} else {
+		v = struct {
+			Rate     int
+			Peaks    []int
+			Segments []Segment
+		}{wf.Rate, wf.Peaks, wf.segments(threshold, minSilence)}
+	}
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("waveformHandler: encode error: %s", err)
+	}
+}
+
+// waveformAnnotateHandler adds an annotation for each segment proposed
+// by silence detection, leaving out segments already annotated with
+// the same label.
+func waveformAnnotateHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "adding annotations requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if !waveformsEnabled() {
+		http.Error(w, "waveforms are not configured", http.StatusNotFound)
+		return
+	}
+	file := r.FormValue("file")
+	libraryLock.RLock()
+	e := library[file]
+	libraryLock.RUnlock()
+	if e == nil || !timedMedia(e) {
+		http.Error(w, "no such file", http.StatusNotFound)
+		return
+	}
+	threshold, minSilence, err := silenceOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	wf, _, err := loadWaveform(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if wf == nil {
+		http.Error(w, "the waveform is still being worked out", http.StatusConflict)
+		return
+	}
+
+	label := r.FormValue("label")
+	if label == "" {
+		label = "Speech"
+	}
+	var add []Annotation
+	for _, s := range wf.segments(threshold, minSilence) {
+		add = append(add, Annotation{Start: s.Start, End: s.End, Label: label})
+	}
+	list, err := addAnnotations(file, add)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if list == nil {
+		list = []Annotation{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		log.Printf("waveformAnnotateHandler: encode error: %s", err)
+	}
+}
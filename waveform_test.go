@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/binary"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// levels builds a waveform at 10 buckets a second from a picture of
+// it, with x for sound and . for silence.
+func levels(picture string) *waveform {
+	w := &waveform{Rate: 10}
+	for _, c := range picture {
+		l := silenceFloor
+		if c == 'x' {
+			l = -10
+		}
+		w.Levels = append(w.Levels, l)
+	}
+	return w
+}
+
+func TestWaveformSegments(t *testing.T) {
+	tests := []struct {
+		name       string
+		picture    string
+		minSilence float64
+		want       []Segment
+	}{
+		{"two", "xxxxxxxxxx..........xxxxxxxxxx", 1, []Segment{{0, 1}, {2, 3}}},
+		{"short pause", "xxxxxxxxxx.....xxxxxxxxxx", 1, []Segment{{0, 2.5}}},
+		{"short pause, short silences", "xxxxxxxxxx.....xxxxxxxxxx", 0.5, []Segment{{0, 1}, {1.5, 2.5}}},
+		{"leading and trailing silence", "..........xxxxxxxx..........", 1, []Segment{{1, 1.8}}},
+		{"blip", "xxxxxxxxxx..........xx..........", 1, []Segment{{0, 1}}},
+		{"silent", "..........", 1, []Segment{}},
+		{"empty", "", 1, []Segment{}},
+		{"no shortest silence", "xxxxx.xxxxx", 0, []Segment{{0, 0.5}, {0.6, 1.1}}},
+	}
+	for _, tt := range tests {
+		got := levels(tt.picture).segments(-40, tt.minSilence)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPeakMeter(t *testing.T) {
+	per := waveformSampleRate / waveformRate
+	pcm := make([]byte, 2*(4*per+1))
+	for i := 0; i < per; i++ {
+		binary.LittleEndian.PutUint16(pcm[2*i:], uint16(16384))
+	}
+	for i := 2 * per; i < 3*per; i++ {
+		v := int16(-32768)
+		if i%2 == 0 {
+			v = 32767
+		}
+		binary.LittleEndian.PutUint16(pcm[2*i:], uint16(v))
+	}
+
+	// Samples split across writes are put back together.
+	m := newPeakMeter()
+	for _, n := range []int{3, 1001, 1, len(pcm) - 1005} {
+		m.Write(pcm[:n])
+		pcm = pcm[n:]
+	}
+	m.flush()
+	if want := []int{50, 0, 100, 0}; !reflect.DeepEqual(m.w.Peaks[:4], want) {
+		t.Errorf("peaks %v, want %v", m.w.Peaks, want)
+	}
+	if want := []int{-6, silenceFloor, 0, silenceFloor}; !reflect.DeepEqual(m.w.Levels[:4], want) {
+		t.Errorf("levels %v, want %v", m.w.Levels, want)
+	}
+	if len(m.w.Peaks) != 5 {
+		t.Errorf("%d buckets, want the partial last one kept", len(m.w.Peaks))
+	}
+}
+
+func TestSilenceOptions(t *testing.T) {
+	threshold, minSilence, err := silenceOptions(httptest.NewRequest("GET", "/waveform?db=-35&min=0.25", nil))
+	if err != nil || threshold != -35 || minSilence != 0.25 {
+		t.Errorf("got %d, %g, %v", threshold, minSilence, err)
+	}
+	for _, q := range []string{"db=5", "db=-101", "db=quiet", "min=0", "min=-1", "min=long"} {
+		if _, _, err := silenceOptions(httptest.NewRequest("GET", "/waveform?"+q, nil)); err == nil {
+			t.Errorf("no error for %s", q)
+		}
+	}
+}
+
+func TestWaveformAnnotateNeedsWaveforms(t *testing.T) {
+	old := *pcmCmd
+	defer func() { *pcmCmd = old }()
+	*pcmCmd = ""
+	defer withLibrary(&LibraryEntry{Filename: "a.mp4", Kind: kindVideo})()
+
+	w := httptest.NewRecorder()
+	waveformAnnotateHandler(w, httptest.NewRequest("POST", "/waveform/annotate?file=a.mp4", strings.NewReader("")))
+	if w.Code != 404 {
+		t.Errorf("status %d, want 404", w.Code)
+	}
+}